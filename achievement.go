@@ -21,3 +21,29 @@ type Achievement struct {
 func (a *Achievement) IsGroup() bool {
 	return (len(a.Achievements) > 0 || len(a.Categories) > 0)
 }
+
+// Progress matches the achievement's criteria against a character's
+// achievement data (see Character.CompletedAchievements), in criteria
+// order. Criteria the character hasn't started have a Quantity of 0.
+// Achievements without criteria return an empty slice.
+func (a *Achievement) Progress(achievements *AchievementList) []*CriteriaProgress {
+	quantities := make(map[int]int)
+	if achievements != nil {
+		for i, id := range achievements.Criteria {
+			if i < len(achievements.CriteriaQuantity) {
+				quantities[id] = achievements.CriteriaQuantity[i]
+			}
+		}
+	}
+	progress := make([]*CriteriaProgress, 0, len(a.Criteria))
+	for _, criteria := range a.Criteria {
+		progress = append(progress, &CriteriaProgress{Criteria: criteria, Quantity: quantities[criteria.Id]})
+	}
+	return progress
+}
+
+// IconURL returns the URL of the achievement's icon at the given size, e.g.
+// IconLarge, on the render host of region.
+func (a *Achievement) IconURL(region Region, size string) string {
+	return iconURL(region, a.Icon, size)
+}
@@ -0,0 +1,21 @@
+package wow
+
+// indexAchievements maps ids to the achievements in achievements and
+// the groups below them. Groups themselves aren't indexed since
+// their ids can collide with achievement ids.
+func indexAchievements(achievements []*Achievement) map[int]*Achievement {
+	byId := make(map[int]*Achievement)
+	var walk func(achievements []*Achievement)
+	walk = func(achievements []*Achievement) {
+		for _, achievement := range achievements {
+			if achievement.IsGroup() {
+				walk(achievement.Achievements)
+				walk(achievement.Categories)
+				continue
+			}
+			byId[achievement.Id] = achievement
+		}
+	}
+	walk(achievements)
+	return byId
+}
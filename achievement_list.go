@@ -1,5 +1,7 @@
 package wow
 
+import "time"
+
 type AchievementList struct {
 	AchievementsCompleted          []int
 	AchievementsCompletedTimestamp []uint64
@@ -8,3 +10,17 @@ type AchievementList struct {
 	CriteriaTimestamp              []uint64
 	CriteriaCreated                []uint64
 }
+
+// Completed maps each completed achievement id to when it was
+// completed.
+func (l *AchievementList) Completed() map[int]time.Time {
+	completed := make(map[int]time.Time, len(l.AchievementsCompleted))
+	for i, id := range l.AchievementsCompleted {
+		var completedAt time.Time
+		if i < len(l.AchievementsCompletedTimestamp) {
+			completedAt = msToTime(int64(l.AchievementsCompletedTimestamp[i]))
+		}
+		completed[id] = completedAt
+	}
+	return completed
+}
@@ -22,3 +22,23 @@ func (s *AchievementSuite) Test_IsGroup_3(c *C) {
 	a := &Achievement{Achievements: []*Achievement{}}
 	c.Assert(a.IsGroup(), Equals, false)
 }
+
+func (s *AchievementSuite) Test_Progress(c *C) {
+	a := &Achievement{Criteria: []*AchievementCriteria{
+		&AchievementCriteria{Id: 1, Max: 5},
+		&AchievementCriteria{Id: 2, Max: 1},
+		&AchievementCriteria{Id: 3, Max: 0},
+	}}
+	progress := a.Progress(&AchievementList{Criteria: []int{1, 2}, CriteriaQuantity: []int{3, 1}})
+	c.Assert(len(progress), Equals, 3)
+	c.Assert(progress[0].Quantity, Equals, 3)
+	c.Assert(progress[0].Percent(), Equals, 60.0)
+	c.Assert(progress[1].IsComplete(), Equals, true)
+	c.Assert(progress[2].Percent(), Equals, 0.0)
+}
+
+func (s *AchievementSuite) Test_Progress_noCriteria(c *C) {
+	a := &Achievement{}
+	c.Assert(a.Progress(&AchievementList{Criteria: []int{1}, CriteriaQuantity: []int{1}}), HasLen, 0)
+	c.Assert(a.Progress(nil), HasLen, 0)
+}
@@ -1,76 +1,335 @@
 package wow
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 type ApiClient struct {
-	Host      string
-	Locale    string
+	Host string
+	// BaseURL, if set, overrides the scheme and Host of every request
+	// and prefixes its path, e.g. to point the client at an
+	// httptest.Server or a proxy.
+	BaseURL *url.URL
+	// PathPrefix is prepended to relative request paths, such as
+	// "item/18803". Defaults to DefaultPathPrefix when empty.
+	PathPrefix string
+	Region     Region
+	Locale     string
+	// Secret is the API key sent with every request.
+	//
+	// Deprecated: Blizzard has retired API keys in favour of OAuth2.
+	// Use NewOAuthApiClient instead.
 	Secret    string
 	PublicKey string
+	// HttpClient is used to make requests. When nil, a shared default
+	// client is used so that connections are pooled across requests.
+	HttpClient *http.Client
+	// Timeout bounds each request, including reading the response
+	// body, except that StreamAuctions only waits up to Timeout for the
+	// response headers. Defaults to DefaultTimeout when zero.
+	Timeout time.Duration
+	// MaxRetries is how many times a request is repeated after a 5xx
+	// or 429 response or network error. Other 4xx responses are never
+	// retried.
+	MaxRetries int
+	// RetryBaseDelay is the delay before the first retry; it doubles
+	// on each subsequent one. Defaults to DefaultRetryBaseDelay.
+	RetryBaseDelay time.Duration
+	// UserAgent is sent with every request. Defaults to
+	// DefaultUserAgent when empty.
+	UserAgent string
+	// BatchConcurrency is how many requests batch methods such as
+	// GetItems make at once. Defaults to DefaultBatchConcurrency.
+	BatchConcurrency int
+	// Cache, if set, stores successful responses for CacheTTL, which
+	// defaults to DefaultCacheTTL. See WithoutCache to bypass it.
+	Cache    Cache
+	CacheTTL time.Duration
+	noCache  bool
+	// Logger, if set, is told about every request made.
+	Logger Logger
+	// Metrics, if set, is called with the outcome of every API call.
+	Metrics MetricsFunc
+	// StrictDecoding makes responses with fields the models don't have
+	// fail to decode, to catch models drifting from the API during
+	// development. Leave it off in production. GetResource isn't
+	// affected, since callers often decode only part of a response, and
+	// neither are the internal types that pick a few fields out of a
+	// response, such as realm links and item search results.
+	StrictDecoding bool
+	// conditional, if set, revalidates responses with ETag and
+	// Last-Modified. See EnableConditionalRequests.
+	conditional *conditionalStore
+	counters    *cacheCounters
+	// lastResponse holds what LastResponseInfo returns.
+	lastResponse *responseInfoStore
+	// connectedRealmIds caches GetConnectedRealmId by realm slug.
+	connectedRealmIds *keyedCache[string, int]
+	// achievements caches the master list for AchievementById, indexed
+	// by id, separately for each locale since clients made by
+	// WithLocale share it.
+	achievements *keyedCache[string, map[int]*Achievement]
+	// itemNames caches ItemName.
+	itemNames *keyedCache[itemNameKey, string]
+	limiter   *rateLimiter
+	tokens    *tokenSource
+	ctx       context.Context
+	// extraParams are added to every request; see WithQueryParams.
+	extraParams map[string]string
 }
 
-var apiClient *ApiClient = nil
+const (
+	DefaultTimeout        = 30 * time.Second
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	DefaultUserAgent      = "blizzard-api-client/1.0"
+	DefaultPathPrefix     = "/wow/"
+	maxRetryDelay         = 30 * time.Second
+)
+
+// Timeouts are applied per request from ApiClient.Timeout, so the
+// shared client doesn't set its own. It is shared by every ApiClient
+// without an HttpClient so that connections are reused between them.
+var defaultHttpClient = &http.Client{Transport: newDefaultTransport()}
+
+// newDefaultTransport returns http.DefaultTransport's settings, but
+// keeping enough idle connections per host for batch methods to reuse
+// all of theirs; the default of 2 means most are redialed.
+func newDefaultTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = DefaultBatchConcurrency
+	return transport
+}
 
+var (
+	currentMu sync.RWMutex
+	apiClient *ApiClient
+)
+
+// CurrentApiClient returns the client registered with
+// SetCurrentApiClient, or nil if there is none. It is used by types
+// such as GuildNewsItem that lazily fetch related data.
 func CurrentApiClient() *ApiClient {
+	currentMu.RLock()
+	defer currentMu.RUnlock()
 	return apiClient
 }
 
-// NewApiClient accepts a region (US, EU, KR, TW, ZH) and an optional
-// associated locale to return a new instance of ApiClient. If the
-// locale is an empty string, the default locale for that region will
-// be used.
-func NewApiClient(region string, locale string) (*ApiClient, error) {
-	var host string
-	var validLocales []string
-	switch region {
-	case "US", "United States":
-		host = "us.api.battle.net"
-		validLocales = []string{"en_US", "es_MX", "pt_BR"}
-	case "EU", "Europe":
-		host = "eu.battle.net"
-		validLocales = []string{"en_GB", "es_ES", "fr_FR", "ru_RU", "de_DE", "pt_PT", "it_IT"}
-	case "KR", "Korea":
-		host = "kr.battle.net"
-		validLocales = []string{"ko_KR"}
-	case "TW", "Taiwan":
-		host = "tw.battle.net"
-		validLocales = []string{"zh_TW"}
-	case "ZH", "CN", "China":
-		host = "www.battle.com.cn"
-		validLocales = []string{"zh_CN"}
-	default:
-		return nil, errors.New(fmt.Sprintf("Region '%s' is not valid", region))
-	}
-
-	var client *ApiClient
-	if locale == "" {
-		client = &ApiClient{Host: host, Locale: validLocales[0]}
-	} else {
-		for _, valid := range validLocales {
-			if valid == locale {
-				client = &ApiClient{Host: host, Locale: locale}
-			}
-		}
+// SetCurrentApiClient registers client as the package-wide default
+// returned by CurrentApiClient. NewApiClient does not do this itself,
+// so several clients for different regions can coexist.
+func SetCurrentApiClient(client *ApiClient) {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+	apiClient = client
+}
+
+// NewApiClient accepts a region (RegionUS, RegionEU, RegionKR,
+// RegionTW, RegionCN, or a name accepted by ParseRegion) and an
+// optional associated locale to return a new instance of ApiClient.
+// If the locale is an empty string, the default locale for that region
+// will be used. Locales are matched ignoring case and surrounding
+// whitespace, and stored in Blizzard's canonical form. Any options are
+// applied to the client before it is returned:
+//
+//	client, err := NewApiClient(RegionEU, "de_DE", WithSecret(key), WithTimeout(10*time.Second))
+func NewApiClient(region Region, locale string, opts ...Option) (*ApiClient, error) {
+	parsed, err := ParseRegion(string(region))
+	if err != nil {
+		return nil, err
+	}
+	info := regions[parsed]
+
+	canonical, ok := info.locale(locale)
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("Locale '%s' is not valid for region '%s'", locale, region))
+	}
+	client := &ApiClient{Host: info.host, Region: parsed, Locale: canonical, counters: &cacheCounters{}, lastResponse: &responseInfoStore{}, connectedRealmIds: &keyedCache[string, int]{}, achievements: &keyedCache[string, map[int]*Achievement]{}, itemNames: &keyedCache[itemNameKey, string]{}}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client, nil
+}
+
+// SetLocale changes the locale used for subsequent requests. The
+// locale must be valid for the client's region; otherwise an error
+// listing the valid locales is returned and the locale is unchanged.
+func (a *ApiClient) SetLocale(locale string) error {
+	info, ok := regions[a.Region]
+	if !ok {
+		return errors.New(fmt.Sprintf("Region '%s' is not valid", a.Region))
 	}
-	if client != nil {
-		apiClient = client
-		return client, nil
+	canonical, ok := info.locale(locale)
+	if !ok {
+		return errors.New(fmt.Sprintf("Locale '%s' is not valid for region '%s'. Valid locales are: %s", locale, a.Region, strings.Join(info.locales, ", ")))
 	}
+	a.Locale = canonical
+	return nil
+}
 
-	return nil, errors.New(fmt.Sprintf("Locale '%s' is not valid for region '%s'", locale, region))
+// Context returns the client's context. The returned context is always
+// non-nil; it defaults to the background context.
+func (a *ApiClient) Context() context.Context {
+	if a.ctx != nil {
+		return a.ctx
+	}
+	return context.Background()
+}
+
+// WithContext returns a shallow copy of the client whose requests are
+// bound to ctx. Cancelling ctx aborts any in-flight request made through
+// the copy, which then returns ctx.Err().
+//
+//	char, err := client.WithContext(ctx).GetCharacter("Runetotem", "Capoferro")
+func (a *ApiClient) WithContext(ctx context.Context) *ApiClient {
+	if ctx == nil {
+		panic("nil context")
+	}
+	client := *a
+	client.ctx = ctx
+	return &client
+}
+
+// SetRateLimit paces outgoing requests to at most requestsPerSecond,
+// allowing bursts of up to burst requests. The limit is shared by all
+// goroutines using the client, including copies made by WithContext.
+// A requestsPerSecond of zero or less removes the limit. Call it
+// before the client is shared.
+func (a *ApiClient) SetRateLimit(requestsPerSecond float64, burst int) {
+	if requestsPerSecond <= 0 {
+		a.limiter = nil
+		return
+	}
+	a.limiter = newRateLimiter(requestsPerSecond, burst)
+}
+
+// EnableConditionalRequests makes the client remember the ETag and
+// Last-Modified headers of responses and send them back as
+// If-None-Match and If-Modified-Since, reusing the remembered body when
+// the API answers 304 Not Modified. This saves bandwidth when polling
+// endpoints such as realm status. Call it before the client is shared.
+func (a *ApiClient) EnableConditionalRequests() {
+	a.conditional = newConditionalStore()
+}
+
+// Close releases the client's resources: HttpClient's idle connections
+// are closed and the cached OAuth token and conditional request
+// validators are discarded. The client remains usable afterwards, and
+// calling Close more than once is harmless. Cache is left alone since
+// the caller owns it, as is the connection pool shared by clients
+// without an HttpClient, since other clients may be using it.
+func (a *ApiClient) Close() error {
+	if a.HttpClient != nil {
+		a.HttpClient.CloseIdleConnections()
+	}
+	if a.tokens != nil {
+		a.tokens.clear()
+	}
+	if a.conditional != nil {
+		a.conditional.clear()
+	}
+	return nil
+}
+
+// CacheStats reports how the client's requests have been answered, for
+// monitoring cache hit rates.
+func (a *ApiClient) CacheStats() CacheStats {
+	return a.counters.stats()
+}
+
+// LastResponseInfo returns the status and quota headers of the most
+// recent response from the API, or nil if there hasn't been one. It is
+// shared with clients derived from this one, e.g. by WithContext.
+func (a *ApiClient) LastResponseInfo() *ResponseInfo {
+	return a.lastResponse.get()
+}
+
+// WithoutCache returns a shallow copy of the client that always makes
+// requests instead of reading from Cache. Fresh responses are still
+// written to the cache.
+//
+//	status, err := client.WithoutCache().GetRealmStatus()
+func (a *ApiClient) WithoutCache() *ApiClient {
+	client := *a
+	client.noCache = true
+	return &client
+}
+
+// WithLocale returns a shallow copy of the client that requests data
+// in locale, leaving the original client untouched. The locale is
+// validated as in SetLocale.
+//
+//	german, err := client.WithLocale("de_DE")
+func (a *ApiClient) WithLocale(locale string) (*ApiClient, error) {
+	client := *a
+	err := client.SetLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
+
+// WithQueryParams returns a shallow copy of the client that adds params
+// to the query of every request, e.g. for parameters the typed methods
+// don't know about yet. Params the method sets itself, such as
+// "fields", take precedence, as do locale and, if Secret is set,
+// apikey. A "namespace" param replaces the automatic one. Calling it on
+// a copy adds to that copy's params.
+//
+//	char, err := client.WithQueryParams(map[string]string{"experimental": "1"}).GetCharacter(realm, name)
+func (a *ApiClient) WithQueryParams(params map[string]string) *ApiClient {
+	client := *a
+	client.extraParams = make(map[string]string, len(a.extraParams)+len(params))
+	for k, v := range a.extraParams {
+		client.extraParams[k] = v
+	}
+	for k, v := range params {
+		client.extraParams[k] = v
+	}
+	return &client
+}
+
+// GetRaw returns the undecoded response body for path, which is
+// relative to /wow/ unless it starts with a slash. It goes through the
+// same authentication, retries and error handling as the typed
+// methods, for fields and endpoints they don't cover yet. A
+// "namespace" param overrides the one picked for game data and profile
+// paths.
+func (a *ApiClient) GetRaw(path string, params map[string]string) ([]byte, error) {
+	queryParams := make(map[string]string, len(params))
+	for k, v := range params {
+		queryParams[k] = v
+	}
+	return a.getWithParams(path, queryParams)
+}
+
+// GetResource fetches resourcePath as GetRaw does and decodes the
+// response into out, which should be a pointer to a struct matching it:
+//
+//	var mount struct{ Id int; Name string }
+//	err := client.GetResource("/data/wow/mount/6", &mount)
+func (a *ApiClient) GetResource(resourcePath string, out interface{}) error {
+	jsonBlob, err := a.get(resourcePath)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonBlob, out)
 }
 
 func (a *ApiClient) GetAchievement(id int) (*Achievement, error) {
@@ -79,7 +338,7 @@ func (a *ApiClient) GetAchievement(id int) (*Achievement, error) {
 		return nil, err
 	}
 	achieve := &Achievement{}
-	err = json.Unmarshal(jsonBlob, achieve)
+	err = a.decode(jsonBlob, achieve)
 	if err != nil {
 		return nil, err
 	}
@@ -87,45 +346,150 @@ func (a *ApiClient) GetAchievement(id int) (*Achievement, error) {
 }
 
 func (a *ApiClient) GetAuctionData(realm string) (*AuctionData, error) {
-	jsonBlob, err := a.get(fmt.Sprintf("auction/data/%s", realm))
+	jsonBlob, err := a.get(fmt.Sprintf("auction/data/%s", url.PathEscape(Slugify(realm))))
 	if err != nil {
 		return nil, err
 	}
 	auctionData := &AuctionData{}
-	err = json.Unmarshal(jsonBlob, auctionData)
+	err = a.decode(jsonBlob, auctionData)
 	if err != nil {
 		return nil, err
 	}
 	return auctionData, nil
 }
 
+// GetAuctionDump fetches the auction data manifest for realm and then
+// downloads and combines the auction files it references.
+// LastModified on the result is the newest of the files' timestamps.
+func (a *ApiClient) GetAuctionDump(realm string) (*AuctionDump, error) {
+	auctionData, err := a.GetAuctionData(realm)
+	if err != nil {
+		return nil, err
+	}
+	return a.downloadAuctionDump(auctionData)
+}
+
+// GetAuctionDumpSince is GetAuctionDump, except that it returns
+// ErrAuctionDataNotModified without downloading anything if the
+// manifest's LastModified is not newer than since.
+func (a *ApiClient) GetAuctionDumpSince(realm string, since int64) (*AuctionDump, error) {
+	auctionData, err := a.GetAuctionData(realm)
+	if err != nil {
+		return nil, err
+	}
+	if auctionData.LastModified() <= since {
+		return nil, ErrAuctionDataNotModified
+	}
+	return a.downloadAuctionDump(auctionData)
+}
+
+// HasNewAuctionData reports whether realm's auction data has changed
+// since the given LastModified timestamp. Only the small manifest is
+// fetched.
+func (a *ApiClient) HasNewAuctionData(realm string, since int64) (bool, error) {
+	auctionData, err := a.GetAuctionData(realm)
+	if err != nil {
+		return false, err
+	}
+	return auctionData.LastModified() > since, nil
+}
+
+func (a *ApiClient) downloadAuctionDump(auctionData *AuctionData) (*AuctionDump, error) {
+	dump := &AuctionDump{LastModified: auctionData.LastModified()}
+	for _, file := range auctionData.Files {
+		fileUrl, err := url.Parse(file.Url)
+		if err != nil {
+			return nil, err
+		}
+		jsonBlob, err := a.getInstrumented(auctionFileEndpoint, fileUrl)
+		if err != nil {
+			return nil, err
+		}
+		fileDump := &AuctionDump{}
+		err = a.decode(jsonBlob, fileDump)
+		if err != nil {
+			return nil, err
+		}
+		dump.Realms = append(dump.Realms, fileDump.Realms...)
+		dump.Auctions = append(dump.Auctions, fileDump.Auctions...)
+	}
+	return dump, nil
+}
+
+// StreamAuctions fetches the auction data manifest for realm and calls fn
+// for each auction in the files it references, decoding them one at a
+// time rather than loading whole files into memory as GetAuctionDump
+// does. If fn returns an error, streaming stops and that error is
+// returned. The client's Timeout only applies until each file's
+// response headers arrive.
+func (a *ApiClient) StreamAuctions(realm string, fn func(*Auction) error) error {
+	auctionData, err := a.GetAuctionData(realm)
+	if err != nil {
+		return err
+	}
+	for _, file := range auctionData.Files {
+		fileUrl, err := url.Parse(file.Url)
+		if err != nil {
+			return err
+		}
+		err = a.stream(auctionFileEndpoint, fileUrl, func(body io.Reader) error {
+			return decodeArrayField(json.NewDecoder(body), "auctions", func(decoder *json.Decoder) error {
+				auction := &Auction{}
+				if err := decoder.Decode(auction); err != nil {
+					return err
+				}
+				return fn(auction)
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *ApiClient) GetBattlePetAbility(id int) (*BattlePetAbility, error) {
 	jsonBlob, err := a.get(fmt.Sprintf("battlePet/ability/%d", id))
 	if err != nil {
 		return nil, err
 	}
 	ability := &BattlePetAbility{}
-	err = json.Unmarshal(jsonBlob, ability)
+	err = a.decode(jsonBlob, ability)
 	if err != nil {
 		return nil, err
 	}
 	return ability, nil
 }
 
+// GetBattlePetAbilities fetches several battle pet abilities
+// concurrently, at most BatchConcurrency at a time. Failures are
+// reported as in GetItems.
+func (a *ApiClient) GetBattlePetAbilities(ids []int) (map[int]*BattlePetAbility, error) {
+	return batchGet(a, ids, a.GetBattlePetAbility)
+}
+
 func (a *ApiClient) GetBattlePetSpecies(id int) (*BattlePetSpecies, error) {
 	jsonBlob, err := a.get(fmt.Sprintf("battlePet/species/%d", id))
 	if err != nil {
 		return nil, err
 	}
 	species := &BattlePetSpecies{}
-	err = json.Unmarshal(jsonBlob, species)
+	err = a.decode(jsonBlob, species)
 	if err != nil {
 		return nil, err
 	}
 	return species, nil
 }
 
+// GetBattlePet returns the stats of species id at the given level,
+// breed and quality. Arguments outside the ranges given by the
+// MinBattlePet and MaxBattlePet constants are rejected without making a
+// request.
 func (a *ApiClient) GetBattlePet(id int, level int, breedId int, qualityId int) (*BattlePet, error) {
+	err := validateBattlePet(level, breedId, qualityId)
+	if err != nil {
+		return nil, err
+	}
 	jsonBlob, err := a.getWithParams(
 		fmt.Sprintf("battlePet/stats/%d", id),
 		map[string]string{
@@ -138,7 +502,7 @@ func (a *ApiClient) GetBattlePet(id int, level int, breedId int, qualityId int)
 	}
 
 	pet := &BattlePet{}
-	err = json.Unmarshal(jsonBlob, pet)
+	err = a.decode(jsonBlob, pet)
 	if err != nil {
 		return nil, err
 	}
@@ -149,18 +513,44 @@ func (a *ApiClient) GetBattlePetStats(id int, level int, breedId int, qualityId
 	return a.GetBattlePet(id, level, breedId, qualityId)
 }
 
+func (a *ApiClient) GetBoss(id int) (*Boss, error) {
+	jsonBlob, err := a.get(fmt.Sprintf("boss/%d", id))
+	if err != nil {
+		return nil, err
+	}
+	boss := &Boss{}
+	err = a.decode(jsonBlob, boss)
+	if err != nil {
+		return nil, err
+	}
+	return boss, nil
+}
+
+func (a *ApiClient) GetBosses() ([]*Boss, error) {
+	jsonBlob, err := a.get("boss/")
+	if err != nil {
+		return nil, err
+	}
+	bossList := &bossList{}
+	err = a.decode(jsonBlob, bossList)
+	if err != nil {
+		return nil, err
+	}
+	return bossList.Bosses, nil
+}
+
 // Will return the ApiClient's region's challenges if realm is empty
 // string.
 func (a *ApiClient) GetChallenges(realm string) ([]*Challenge, error) {
 	if realm == "" {
 		realm = "region"
 	}
-	jsonBlob, err := a.get(fmt.Sprintf("challenge/%s", realm))
+	jsonBlob, err := a.get(fmt.Sprintf("challenge/%s", url.PathEscape(Slugify(realm))))
 	if err != nil {
 		return nil, err
 	}
 	challengeSet := &challengeList{}
-	err = json.Unmarshal(jsonBlob, challengeSet)
+	err = a.decode(jsonBlob, challengeSet)
 	if err != nil {
 		return nil, err
 	}
@@ -176,21 +566,47 @@ func (a *ApiClient) GetCharacter(realm string, characterName string) (*Character
 }
 
 func (a *ApiClient) GetCharacterWithFields(realm string, characterName string, fields []string) (*Character, error) {
-	err := validateCharacterFields(fields)
+	char := NewCharacter(a)
+	err := a.loadCharacter(char, realm, characterName, fields)
 	if err != nil {
 		return nil, err
 	}
-	jsonBlob, err := a.getWithParams(fmt.Sprintf("character/%s/%s", realm, characterName), map[string]string{"fields": strings.Join(fields, ",")})
+	return char, nil
+}
 
+// CharacterExists reports whether the character exists, fetching only
+// its basic profile. A 404 from the API means it doesn't; other
+// failures are returned as errors.
+func (a *ApiClient) CharacterExists(realm string, characterName string) (bool, error) {
+	_, err := a.get(fmt.Sprintf("character/%s/%s", url.PathEscape(Slugify(realm)), url.PathEscape(characterName)))
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-	char := NewCharacter(a)
-	err = json.Unmarshal(jsonBlob, char)
+	return true, nil
+}
+
+// loadCharacter fetches the character with the given fields into char.
+// Sections of char not included in the response are left untouched.
+func (a *ApiClient) loadCharacter(char *Character, realm string, characterName string, fields []string) error {
+	err := validateCharacterFields(fields)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return char, nil
+	jsonBlob, err := a.getWithParams(fmt.Sprintf("character/%s/%s", url.PathEscape(Slugify(realm)), url.PathEscape(characterName)), map[string]string{"fields": strings.Join(fields, ",")})
+	if err != nil {
+		return err
+	}
+	err = a.decode(jsonBlob, char)
+	if err != nil {
+		return err
+	}
+	char.RawJSON = jsonBlob
+	char.markLoaded(fields)
+	return nil
 }
 
 func (a *ApiClient) GetItem(id int) (*Item, error) {
@@ -198,7 +614,7 @@ func (a *ApiClient) GetItem(id int) (*Item, error) {
 	if err != nil {
 		return nil, err
 	}
-	item, err := NewItemFromJson(jsonBlob)
+	item, err := newItem(jsonBlob, a.decode)
 	if err != nil {
 		return nil, err
 	}
@@ -206,101 +622,465 @@ func (a *ApiClient) GetItem(id int) (*Item, error) {
 	return item, err
 }
 
+// GetItems fetches several items concurrently, at most
+// BatchConcurrency at a time. If some requests fail, the items that
+// were fetched are returned along with a *BatchError holding the error
+// for each failed id.
+func (a *ApiClient) GetItems(ids []int) (map[int]*Item, error) {
+	return batchGet(a, ids, a.GetItem)
+}
+
+// GetItemWithBonusLists returns the item as modified by the given
+// bonus list ids, e.g. for warforged or socketed variants.
+func (a *ApiClient) GetItemWithBonusLists(id int, bonusLists []int) (*Item, error) {
+	bl := make([]string, 0, len(bonusLists))
+	for _, bonusList := range bonusLists {
+		bl = append(bl, strconv.Itoa(bonusList))
+	}
+	jsonBlob, err := a.getWithParams(fmt.Sprintf("item/%d", id), map[string]string{"bl": strings.Join(bl, ",")})
+	if err != nil {
+		return nil, err
+	}
+	return newItem(jsonBlob, a.decode)
+}
+
+// GetItemLocale is GetItem with the client's locale overridden for
+// this request only.
+func (a *ApiClient) GetItemLocale(id int, locale string) (*Item, error) {
+	client, err := a.WithLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetItem(id)
+}
+
+// SearchItems returns up to 100 items matching name in the client's
+// locale, ordered by id, from the game data API's item search. Only
+// the fields the search returns are set on the items. Search is only
+// served by the OAuth API gateway, the host NewOAuthApiClient uses,
+// so a client with neither OAuth credentials nor a Secret gets an
+// error without a request being sent.
+func (a *ApiClient) SearchItems(name string) ([]*Item, error) {
+	if a.tokens == nil && len(a.Secret) == 0 {
+		return nil, errors.New("Item search needs OAuth credentials or a Secret: it is only served by the OAuth API gateway")
+	}
+	jsonBlob, err := a.getWithParams("/data/wow/search/item", map[string]string{
+		"name." + a.Locale: name,
+		"orderby":          "id",
+		"_pageSize":        "100",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := &itemSearchResultList{}
+	err = json.Unmarshal(jsonBlob, list)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*Item, 0, len(list.Results))
+	for _, result := range list.Results {
+		if result.Data != nil {
+			items = append(items, result.Data.item(a.Locale))
+		}
+	}
+	return items, nil
+}
+
+// SearchItemsLocale is SearchItems with name matched, and the names
+// returned, in locale instead of the client's locale.
+func (a *ApiClient) SearchItemsLocale(name string, locale string) ([]*Item, error) {
+	client, err := a.WithLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	return client.SearchItems(name)
+}
+
+// ItemName returns the item's name in locale, which must be valid for
+// the client's region. Each item is fetched once per locale and the
+// names kept for the life of the client, for rendering the same items
+// in several languages.
+func (a *ApiClient) ItemName(id int, locale string) (string, error) {
+	client, err := a.WithLocale(locale)
+	if err != nil {
+		return "", err
+	}
+	if name, ok := a.itemNames.get(itemNameKey{id, client.Locale}); ok {
+		return name, nil
+	}
+	item, err := client.GetItem(id)
+	if err != nil {
+		return "", err
+	}
+	a.itemNames.set(itemNameKey{id, client.Locale}, item.Name)
+	return item.Name, nil
+}
+
 func (a *ApiClient) GetItemSet(id int) (*ItemSet, error) {
 	jsonBlob, err := a.get(fmt.Sprintf("item/set/%d", id))
 	if err != nil {
 		return nil, err
 	}
-	itemSet := &ItemSet{}
-	err = json.Unmarshal(jsonBlob, itemSet)
+	itemSet := &ItemSet{}
+	err = a.decode(jsonBlob, itemSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return itemSet, err
+}
+
+// GetItemSets fetches several item sets concurrently, in the same way
+// as GetItems.
+func (a *ApiClient) GetItemSets(ids []int) (map[int]*ItemSet, error) {
+	return batchGet(a, ids, a.GetItemSet)
+}
+
+func (a *ApiClient) GetGuild(realm string, guildName string) (*Guild, error) {
+	return a.GetGuildWithFields(realm, guildName, make([]string, 0))
+}
+
+func (a *ApiClient) GetGuildWithFields(realm string, guildName string, fields []string) (*Guild, error) {
+	guild := &Guild{ApiClient: a}
+	err := a.loadGuild(guild, realm, guildName, fields)
+	if err != nil {
+		return nil, err
+	}
+	return guild, nil
+}
+
+// loadGuild fetches the guild with the given fields into guild, in the
+// same way as loadCharacter.
+func (a *ApiClient) loadGuild(guild *Guild, realm string, guildName string, fields []string) error {
+	err := validateGuildFields(fields)
+	if err != nil {
+		return err
+	}
+	jsonBlob, err := a.getWithParams(fmt.Sprintf("guild/%s/%s", url.PathEscape(Slugify(realm)), url.PathEscape(guildName)), map[string]string{"fields": strings.Join(fields, ",")})
+	if err != nil {
+		return err
+	}
+	return a.decode(jsonBlob, guild)
+}
+
+func (a *ApiClient) GetPvPLeaderboard(bracket Bracket) ([]*PvPLeaderboardRow, error) {
+	err := validateBracket(bracket)
+	if err != nil {
+		return nil, err
+	}
+	jsonBlob, err := a.get(fmt.Sprintf("leaderboard/%s", bracket))
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboard := &pvpLeaderboard{}
+	err = a.decode(jsonBlob, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+	return leaderboard.Rows, nil
+}
+
+// GetPvPLeaderboardRange returns up to limit rows of the bracket's
+// leaderboard starting at offset, for when only part of a large
+// leaderboard is needed. The API has no paging, so the full response
+// is still downloaded, but rows outside the range are not decoded.
+// A limit of 0 or less returns every row from offset on.
+func (a *ApiClient) GetPvPLeaderboardRange(bracket Bracket, offset int, limit int) ([]*PvPLeaderboardRow, error) {
+	err := validateBracket(bracket)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 {
+		return nil, errors.New(fmt.Sprintf("Invalid leaderboard offset %d", offset))
+	}
+	jsonBlob, err := a.get(fmt.Sprintf("leaderboard/%s", bracket))
+	if err != nil {
+		return nil, err
+	}
+	return decodePvPLeaderboardRange(jsonBlob, offset, limit)
+}
+
+// GetMythicKeystoneLeaderboard returns the leading Mythic Keystone
+// groups for a dungeon on a connected realm during the given weekly
+// period. It uses the game data API, which requires an OAuth client
+// (see NewOAuthApiClient).
+func (a *ApiClient) GetMythicKeystoneLeaderboard(connectedRealmId int, dungeonId int, period int) (*MythicKeystoneLeaderboard, error) {
+	jsonBlob, err := a.get(fmt.Sprintf("/data/wow/connected-realm/%d/mythic-leaderboard/%d/period/%d", connectedRealmId, dungeonId, period))
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboard := &MythicKeystoneLeaderboard{}
+	err = a.decode(jsonBlob, leaderboard)
+	if err != nil {
+		return nil, err
+	}
+	return leaderboard, nil
+}
+
+func (a *ApiClient) GetQuest(id int) (*Quest, error) {
+	jsonBlob, err := a.get(fmt.Sprintf("quest/%d", id))
+	if err != nil {
+		return nil, err
+	}
+
+	quest := &Quest{}
+	err = a.decode(jsonBlob, quest)
+	if err != nil {
+		return nil, err
+	}
+	return quest, nil
+}
+
+// GetQuests fetches several quests concurrently, in the same way as
+// GetItems. Quests that don't exist are reported in the *BatchError
+// with a 404 *ApiError.
+func (a *ApiClient) GetQuests(ids []int) (map[int]*Quest, error) {
+	return batchGet(a, ids, a.GetQuest)
+}
+
+func (a *ApiClient) GetRealmStatus() ([]*RealmStatus, error) {
+	return a.GetRealmStatusFor()
+}
+
+// GetRealmStatusFor returns the status of only the realms with the
+// given slugs, or of every realm if none are given.
+func (a *ApiClient) GetRealmStatusFor(realms ...string) ([]*RealmStatus, error) {
+	params := make(map[string]string)
+	if len(realms) > 0 {
+		slugs := make([]string, 0, len(realms))
+		for _, realm := range realms {
+			slugs = append(slugs, Slugify(realm))
+		}
+		params["realms"] = strings.Join(slugs, ",")
+	}
+	jsonBlob, err := a.getWithParams("realm/status", params)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &realmStatusList{}
+	err = a.decode(jsonBlob, list)
+	if err != nil {
+		return nil, err
+	}
+	return list.Realms, nil
+}
+
+// GetTokenPrice returns the current WoW Token price in the client's
+// region from the game data API, which requires an OAuth client (see
+// NewOAuthApiClient).
+func (a *ApiClient) GetTokenPrice() (*TokenPrice, error) {
+	jsonBlob, err := a.get("/data/wow/token/index")
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPrice := &TokenPrice{}
+	err = a.decode(jsonBlob, tokenPrice)
+	if err != nil {
+		return nil, err
+	}
+	return tokenPrice, nil
+}
+
+// GetConnectedRealm returns the connected realm with the given id from
+// the game data API, which requires an OAuth client (see
+// NewOAuthApiClient).
+func (a *ApiClient) GetConnectedRealm(id int) (*ConnectedRealm, error) {
+	jsonBlob, err := a.get(fmt.Sprintf("/data/wow/connected-realm/%d", id))
+	if err != nil {
+		return nil, err
+	}
+
+	connectedRealm := &ConnectedRealm{}
+	err = a.decode(jsonBlob, connectedRealm)
+	if err != nil {
+		return nil, err
+	}
+	return connectedRealm, nil
+}
+
+// GetConnectedRealmId returns the id of the connected realm that the
+// realm belongs to. Results are cached for the life of the client.
+func (a *ApiClient) GetConnectedRealmId(realm string) (int, error) {
+	slug := Slugify(realm)
+	if id, ok := a.connectedRealmIds.get(slug); ok {
+		return id, nil
+	}
+	jsonBlob, err := a.get(fmt.Sprintf("/data/wow/realm/%s", url.PathEscape(slug)))
+	if err != nil {
+		return 0, err
+	}
+
+	link := &realmLink{}
+	err = json.Unmarshal(jsonBlob, link)
+	if err != nil {
+		return 0, err
+	}
+	if link.ConnectedRealm == nil {
+		return 0, errors.New(fmt.Sprintf("Realm '%s' has no connected realm", slug))
+	}
+	id, err := connectedRealmLinkId(link.ConnectedRealm.Href)
+	if err != nil {
+		return 0, err
+	}
+	a.connectedRealmIds.set(slug, id)
+	return id, nil
+}
+
+// GetConnectedRealmAuctions returns the current auctions of the
+// connected realm that realm belongs to, along with the connected
+// realm itself.
+func (a *ApiClient) GetConnectedRealmAuctions(realm string) (*ConnectedRealmAuctions, error) {
+	id, err := a.GetConnectedRealmId(realm)
+	if err != nil {
+		return nil, err
+	}
+	connectedRealm, err := a.GetConnectedRealm(id)
+	if err != nil {
+		return nil, err
+	}
+	jsonBlob, err := a.get(fmt.Sprintf("/data/wow/connected-realm/%d/auctions", id))
+	if err != nil {
+		return nil, err
+	}
+
+	list := &connectedRealmAuctionList{}
+	err = json.Unmarshal(jsonBlob, list)
+	if err != nil {
+		return nil, err
+	}
+	auctions := make([]*Auction, 0, len(list.Auctions))
+	for _, auction := range list.Auctions {
+		auctions = append(auctions, auction.auction())
+	}
+	return &ConnectedRealmAuctions{ConnectedRealm: connectedRealm, Auctions: auctions}, nil
+}
+
+// GetConnectedRealmIds returns the ids of every connected realm in the
+// client's region.
+func (a *ApiClient) GetConnectedRealmIds() ([]int, error) {
+	jsonBlob, err := a.get("/data/wow/connected-realm/index")
+	if err != nil {
+		return nil, err
+	}
+
+	index := &connectedRealmIndex{}
+	err = json.Unmarshal(jsonBlob, index)
+	if err != nil {
+		return nil, err
+	}
+	return index.Ids()
+}
+
+// GetConnectedRealms returns every connected realm in the client's
+// region. The API only lists their ids, so this makes one request per
+// connected realm.
+func (a *ApiClient) GetConnectedRealms() ([]*ConnectedRealm, error) {
+	ids, err := a.GetConnectedRealmIds()
+	if err != nil {
+		return nil, err
+	}
+	connectedRealms := make([]*ConnectedRealm, 0, len(ids))
+	for _, id := range ids {
+		connectedRealm, err := a.GetConnectedRealm(id)
+		if err != nil {
+			return nil, err
+		}
+		connectedRealms = append(connectedRealms, connectedRealm)
+	}
+	return connectedRealms, nil
+}
+
+func (a *ApiClient) GetRecipe(id int) (*Recipe, error) {
+	jsonBlob, err := a.get(fmt.Sprintf("recipe/%d", id))
+	if err != nil {
+		return nil, err
+	}
+
+	recipe := &Recipe{}
+	err = a.decode(jsonBlob, recipe)
 	if err != nil {
 		return nil, err
 	}
-
-	return itemSet, err
+	return recipe, nil
 }
 
-func (a *ApiClient) GetGuild(realm string, guildName string) (*Guild, error) {
-	return a.GetGuildWithFields(realm, guildName, make([]string, 0))
+// GetRecipes fetches several recipes concurrently, in the same way as
+// GetItems.
+func (a *ApiClient) GetRecipes(ids []int) (map[int]*Recipe, error) {
+	return batchGet(a, ids, a.GetRecipe)
 }
 
-func (a *ApiClient) GetGuildWithFields(realm string, guildName string, fields []string) (*Guild, error) {
-	err := validateGuildFields(fields)
-	if err != nil {
-		return nil, err
-	}
-	jsonBlob, err := a.getWithParams(fmt.Sprintf("guild/%s/%s", realm, url.QueryEscape(guildName)), map[string]string{"fields": strings.Join(fields, ",")})
+func (a *ApiClient) GetSpell(id int) (*Spell, error) {
+	jsonBlob, err := a.get(fmt.Sprintf("spell/%d", id))
 	if err != nil {
 		return nil, err
 	}
-	guild := &Guild{}
-	err = json.Unmarshal(jsonBlob, guild)
+
+	spell := &Spell{}
+	err = a.decode(jsonBlob, spell)
 	if err != nil {
 		return nil, err
 	}
-	return guild, nil
+	return spell, nil
 }
 
-func (a *ApiClient) GetPvPLeaderboard(bracket string) ([]*PvPLeaderboardRow, error) {
-	jsonBlob, err := a.get(fmt.Sprintf("leaderboard/%s", bracket))
+// GetSpells fetches several spells concurrently, in the same way as
+// GetItems.
+func (a *ApiClient) GetSpells(ids []int) (map[int]*Spell, error) {
+	return batchGet(a, ids, a.GetSpell)
+}
 
-	leaderboard := &pvpLeaderboard{}
-	err = json.Unmarshal(jsonBlob, leaderboard)
+// GetSpellLocale is GetSpell with the client's locale overridden for
+// this request only.
+func (a *ApiClient) GetSpellLocale(id int, locale string) (*Spell, error) {
+	client, err := a.WithLocale(locale)
 	if err != nil {
 		return nil, err
 	}
-	return leaderboard.Rows, nil
+	return client.GetSpell(id)
 }
 
-func (a *ApiClient) GetQuest(id int) (*Quest, error) {
-	jsonBlob, err := a.get(fmt.Sprintf("quest/%d", id))
-
-	quest := &Quest{}
-	err = json.Unmarshal(jsonBlob, quest)
+func (a *ApiClient) GetZone(id int) (*Zone, error) {
+	jsonBlob, err := a.get(fmt.Sprintf("zone/%d", id))
 	if err != nil {
 		return nil, err
 	}
-	return quest, nil
-}
 
-func (a *ApiClient) GetRealmStatus() ([]*RealmStatus, error) {
-	jsonBlob, err := a.get("realm/status")
-
-	list := &realmStatusList{}
-	err = json.Unmarshal(jsonBlob, list)
+	zone := &Zone{}
+	err = a.decode(jsonBlob, zone)
 	if err != nil {
 		return nil, err
 	}
-	return list.Realms, nil
+	return zone, nil
 }
 
-func (a *ApiClient) GetRecipe(id int) (*Recipe, error) {
-	jsonBlob, err := a.get(fmt.Sprintf("recipe/%d", id))
-
-	recipe := &Recipe{}
-	err = json.Unmarshal(jsonBlob, recipe)
+func (a *ApiClient) GetZones() ([]*Zone, error) {
+	jsonBlob, err := a.get("zone/")
 	if err != nil {
 		return nil, err
 	}
-	return recipe, nil
-}
-
-func (a *ApiClient) GetSpell(id int) (*Spell, error) {
-	jsonBlob, err := a.get(fmt.Sprintf("spell/%d", id))
 
-	spell := &Spell{}
-	err = json.Unmarshal(jsonBlob, spell)
+	zoneList := &zoneList{}
+	err = a.decode(jsonBlob, zoneList)
 	if err != nil {
 		return nil, err
 	}
-	return spell, nil
+	return zoneList.Zones, nil
 }
 
 func (a *ApiClient) GetBattlegroups() ([]*Battlegroup, error) {
 	jsonBlob, err := a.get("data/battlegroups/")
+	if err != nil {
+		return nil, err
+	}
 
 	battlegroupList := &battlegroupList{}
-	err = json.Unmarshal(jsonBlob, battlegroupList)
+	err = a.decode(jsonBlob, battlegroupList)
 	if err != nil {
 		return nil, err
 	}
@@ -309,9 +1089,12 @@ func (a *ApiClient) GetBattlegroups() ([]*Battlegroup, error) {
 
 func (a *ApiClient) GetRaces() ([]*Race, error) {
 	jsonBlob, err := a.get("data/character/races")
+	if err != nil {
+		return nil, err
+	}
 
 	raceList := &raceList{}
-	err = json.Unmarshal(jsonBlob, raceList)
+	err = a.decode(jsonBlob, raceList)
 	if err != nil {
 		return nil, err
 	}
@@ -320,9 +1103,12 @@ func (a *ApiClient) GetRaces() ([]*Race, error) {
 
 func (a *ApiClient) GetClasses() ([]*Class, error) {
 	jsonBlob, err := a.get("data/character/classes")
+	if err != nil {
+		return nil, err
+	}
 
 	classList := &classList{}
-	err = json.Unmarshal(jsonBlob, classList)
+	err = a.decode(jsonBlob, classList)
 	if err != nil {
 		return nil, err
 	}
@@ -331,20 +1117,62 @@ func (a *ApiClient) GetClasses() ([]*Class, error) {
 
 func (a *ApiClient) GetAchievements() ([]*Achievement, error) {
 	jsonBlob, err := a.get("data/character/achievements")
+	if err != nil {
+		return nil, err
+	}
 
 	achievementList := &achievementData{}
-	err = json.Unmarshal(jsonBlob, achievementList)
+	err = a.decode(jsonBlob, achievementList)
 	if err != nil {
 		return nil, err
 	}
 	return achievementList.Achievements, nil
 }
 
+// AchievementById returns the achievement with the given id from the
+// master list. The list is fetched with GetAchievements on first use
+// and then kept for the life of the client; see RefreshAchievements.
+func (a *ApiClient) AchievementById(id int) (*Achievement, error) {
+	byId, ok := a.achievements.get(a.Locale)
+	if !ok {
+		var err error
+		byId, err = a.refreshAchievements()
+		if err != nil {
+			return nil, err
+		}
+	}
+	achievement, ok := byId[id]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("Achievement %d not found", id))
+	}
+	return achievement, nil
+}
+
+// RefreshAchievements refetches the master list used by
+// AchievementById, e.g. after a patch adds achievements.
+func (a *ApiClient) RefreshAchievements() error {
+	_, err := a.refreshAchievements()
+	return err
+}
+
+func (a *ApiClient) refreshAchievements() (map[int]*Achievement, error) {
+	achievements, err := a.GetAchievements()
+	if err != nil {
+		return nil, err
+	}
+	byId := indexAchievements(achievements)
+	a.achievements.set(a.Locale, byId)
+	return byId, nil
+}
+
 func (a *ApiClient) GetGuildRewards() ([]*GuildReward, error) {
 	jsonBlob, err := a.get("data/guild/rewards")
+	if err != nil {
+		return nil, err
+	}
 
 	guildRewardList := &guildRewardList{}
-	err = json.Unmarshal(jsonBlob, guildRewardList)
+	err = a.decode(jsonBlob, guildRewardList)
 	if err != nil {
 		return nil, err
 	}
@@ -353,9 +1181,12 @@ func (a *ApiClient) GetGuildRewards() ([]*GuildReward, error) {
 
 func (a *ApiClient) GetGuildPerks() ([]*GuildPerk, error) {
 	jsonBlob, err := a.get("data/guild/perks")
+	if err != nil {
+		return nil, err
+	}
 
 	guildPerkList := &guildPerkList{}
-	err = json.Unmarshal(jsonBlob, guildPerkList)
+	err = a.decode(jsonBlob, guildPerkList)
 	if err != nil {
 		return nil, err
 	}
@@ -364,9 +1195,12 @@ func (a *ApiClient) GetGuildPerks() ([]*GuildPerk, error) {
 
 func (a *ApiClient) GetGuildAchievements() ([]*Achievement, error) {
 	jsonBlob, err := a.get("data/guild/achievements")
+	if err != nil {
+		return nil, err
+	}
 
 	guildAchievementList := &guildAchievementList{}
-	err = json.Unmarshal(jsonBlob, guildAchievementList)
+	err = a.decode(jsonBlob, guildAchievementList)
 	if err != nil {
 		return nil, err
 	}
@@ -375,9 +1209,12 @@ func (a *ApiClient) GetGuildAchievements() ([]*Achievement, error) {
 
 func (a *ApiClient) GetItemClasses() ([]*ItemClass, error) {
 	jsonBlob, err := a.get("data/item/classes")
+	if err != nil {
+		return nil, err
+	}
 
 	itemClassList := &itemClassList{}
-	err = json.Unmarshal(jsonBlob, itemClassList)
+	err = a.decode(jsonBlob, itemClassList)
 	if err != nil {
 		return nil, err
 	}
@@ -386,26 +1223,60 @@ func (a *ApiClient) GetItemClasses() ([]*ItemClass, error) {
 
 func (a *ApiClient) GetTalents() (*ClassTalentList, error) {
 	jsonBlob, err := a.get("data/talents")
+	if err != nil {
+		return nil, err
+	}
 
 	talents := &ClassTalentList{}
-	err = json.Unmarshal(jsonBlob, talents)
+	err = a.decode(jsonBlob, talents)
 	if err != nil {
 		return nil, err
 	}
 	return talents, nil
 }
 
+// TalentsForClass returns the talent tiers, specs and glyphs of the
+// class with the given id.
+func (a *ApiClient) TalentsForClass(classId int) (*TalentList, error) {
+	talents, err := a.GetTalents()
+	if err != nil {
+		return nil, err
+	}
+	list := talents.ForClass(classId)
+	if list == nil {
+		return nil, errors.New(fmt.Sprintf("No talents for class %d", classId))
+	}
+	return list, nil
+}
+
 func (a *ApiClient) GetPetTypes() ([]*PetType, error) {
 	jsonBlob, err := a.get("data/pet/types")
+	if err != nil {
+		return nil, err
+	}
 
 	petTypes := &petTypeList{}
-	err = json.Unmarshal(jsonBlob, petTypes)
+	err = a.decode(jsonBlob, petTypes)
 	if err != nil {
 		return nil, err
 	}
 	return petTypes.PetTypes, nil
 }
 
+func (a *ApiClient) GetMounts() ([]*Mount, error) {
+	jsonBlob, err := a.get("mount/")
+	if err != nil {
+		return nil, err
+	}
+
+	mounts := &mountData{}
+	err = a.decode(jsonBlob, mounts)
+	if err != nil {
+		return nil, err
+	}
+	return mounts.Mounts, nil
+}
+
 func validateGuildFields(fields []string) error {
 	validFields := []string{
 		"members",
@@ -458,50 +1329,417 @@ func validateFields(validFields []string, fields []string) error {
 	}
 }
 
+// decode unmarshals a response into v, rejecting unknown fields if
+// StrictDecoding is set.
+func (a *ApiClient) decode(jsonBlob []byte, v interface{}) error {
+	if !a.StrictDecoding {
+		return json.Unmarshal(jsonBlob, v)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(jsonBlob))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
 func (a *ApiClient) get(path string) ([]byte, error) {
 	return a.getWithParams(path, make(map[string]string))
 }
 
+func (a *ApiClient) httpClient() *http.Client {
+	if a.HttpClient != nil {
+		return a.HttpClient
+	}
+	return defaultHttpClient
+}
+
+// pathPrefix returns PathPrefix, or DefaultPathPrefix if it is empty,
+// with the slashes around it that url() relies on.
+func (a *ApiClient) pathPrefix() string {
+	if a.PathPrefix == "" {
+		return DefaultPathPrefix
+	}
+	prefix := strings.Trim(a.PathPrefix, "/")
+	if prefix == "" {
+		return "/"
+	}
+	return "/" + prefix + "/"
+}
+
+func (a *ApiClient) userAgent() string {
+	if a.UserAgent != "" {
+		return a.UserAgent
+	}
+	return DefaultUserAgent
+}
+
+func (a *ApiClient) timeout() time.Duration {
+	if a.Timeout > 0 {
+		return a.Timeout
+	}
+	return DefaultTimeout
+}
+
 func (a *ApiClient) getWithParams(path string, queryParams map[string]string) ([]byte, error) {
-	client := &http.Client{}
-	var url *url.URL
-	var request *http.Request
-	var err error
+	url := a.url(path, queryParams, len(a.Secret) > 0 || a.tokens != nil)
+	return a.getInstrumented(endpointLabel(path), url)
+}
 
-	if len(a.Secret) > 0 {
-		url = a.url(path, queryParams, true)
-		request, err = http.NewRequest("GET", url.String(), nil)
-		if err != nil {
-			return make([]byte, 0), err
+// getInstrumented fetches url through the cache, reporting the call to
+// Metrics under endpoint.
+func (a *ApiClient) getInstrumented(endpoint string, url *url.URL) (body []byte, err error) {
+	if a.Metrics != nil {
+		start := time.Now()
+		defer func() {
+			a.Metrics(endpoint, statusCode(err), time.Since(start))
+		}()
+	}
+	if a.Cache == nil {
+		return a.getUrl(url)
+	}
+
+	key := cacheKey(url)
+	if !a.noCache {
+		if body, ok := a.Cache.Get(key); ok {
+			a.counters.hit()
+			return body, nil
 		}
-	} else {
-		url = a.url(path, queryParams, false)
-		request, err = http.NewRequest("GET", url.String(), nil)
-		if err != nil {
+	}
+	body, err = a.getUrl(url)
+	if err != nil {
+		return body, err
+	}
+	a.Cache.Set(key, body, a.cacheTTL())
+	return body, nil
+}
+
+func (a *ApiClient) cacheTTL() time.Duration {
+	if a.CacheTTL > 0 {
+		return a.CacheTTL
+	}
+	return DefaultCacheTTL
+}
+
+// getUrl fetches an absolute URL, retrying as configured. Credentials
+// are only sent when the URL points at the client's own Host.
+func (a *ApiClient) getUrl(url *url.URL) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		body, err := a.fetch(url, true)
+		if a.Logger != nil {
+			a.Logger.LogRequest(&RequestEvent{
+				Path:       url.Path,
+				StatusCode: statusCode(err),
+				Duration:   time.Since(start),
+				Attempt:    attempt,
+				Err:        err,
+			})
+		}
+		if err == nil || attempt >= a.MaxRetries || !a.shouldRetry(err) {
+			return body, err
+		}
+		select {
+		case <-time.After(a.retryDelay(err, attempt)):
+		case <-a.Context().Done():
+			return make([]byte, 0), a.Context().Err()
+		}
+	}
+}
+
+// fetch makes a single GET request for url and returns the response
+// body, or an error for transport failures and non-2xx responses. If
+// refreshToken is set and an OAuth token is rejected with a 401, the
+// token is refreshed and the request repeated once.
+func (a *ApiClient) fetch(url *url.URL, refreshToken bool) ([]byte, error) {
+	if a.limiter != nil {
+		if err := a.limiter.wait(a.Context()); err != nil {
 			return make([]byte, 0), err
 		}
 	}
 
-	response, err := client.Do(request)
+	ctx, cancel := context.WithTimeout(a.Context(), a.timeout())
+	defer cancel()
+
+	request, token, err := a.newRequest(ctx, url)
+	if err != nil {
+		return make([]byte, 0), err
+	}
+	var conditional *conditionalEntry
+	if a.conditional != nil {
+		conditional = a.conditional.get(cacheKey(url))
+		if conditional != nil {
+			conditional.addHeaders(request)
+		}
+	}
+
+	response, err := a.httpClient().Do(request)
 	if err != nil {
+		if ctx.Err() != nil {
+			return make([]byte, 0), ctx.Err()
+		}
 		return make([]byte, 0), err
 	}
 	defer response.Body.Close()
+	if url.Host == a.apiHost() {
+		a.lastResponse.set(newResponseInfo(response))
+	}
 
-	body, err := ioutil.ReadAll(response.Body)
+	body, err := readBody(response)
 	if err != nil {
+		if ctx.Err() != nil {
+			return make([]byte, 0), ctx.Err()
+		}
+		return make([]byte, 0), err
+	}
+
+	if response.StatusCode == http.StatusNotModified && conditional != nil {
+		a.counters.revalidated()
+		return conditional.body, nil
+	}
+
+	if response.StatusCode == http.StatusUnauthorized && refreshToken && a.tokens != nil {
+		a.tokens.invalidate(token)
+		return a.fetch(url, false)
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return make([]byte, 0), responseError(url, response, body)
+	}
+	if err := emptyBodyError(url, body); err != nil {
 		return make([]byte, 0), err
 	}
 
+	a.counters.miss()
+	if a.conditional != nil {
+		a.conditional.set(cacheKey(url), response.Header, body)
+	}
 	return body, nil
 }
 
+// stream makes a single GET request for url like fetch, but passes the
+// body to read as it arrives instead of buffering it, for responses too
+// large to hold in memory. It doesn't retry, cache or revalidate. The
+// client's timeout only covers the wait for the response headers, as
+// reading a large body can legitimately take longer; the body is still
+// bounded by the client's context. The request is reported to the
+// Logger and Metrics hooks once it is done, the latter under endpoint.
+func (a *ApiClient) stream(endpoint string, url *url.URL, read func(body io.Reader) error) (err error) {
+	start := time.Now()
+	status := 0
+	defer func() {
+		if status == 0 {
+			status = statusCode(err)
+		}
+		if a.Logger != nil {
+			a.Logger.LogRequest(&RequestEvent{
+				Path:       url.Path,
+				StatusCode: status,
+				Duration:   time.Since(start),
+				Err:        err,
+			})
+		}
+		if a.Metrics != nil {
+			a.Metrics(endpoint, status, time.Since(start))
+		}
+	}()
+
+	if a.limiter != nil {
+		if err := a.limiter.wait(a.Context()); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(a.Context())
+	defer cancel()
+	timer := time.AfterFunc(a.timeout(), cancel)
+
+	request, _, err := a.newRequest(ctx, url)
+	if err != nil {
+		timer.Stop()
+		return err
+	}
+	response, err := a.httpClient().Do(request)
+	if !timer.Stop() {
+		if err == nil {
+			response.Body.Close()
+		}
+		return context.DeadlineExceeded
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	defer response.Body.Close()
+	if url.Host == a.apiHost() {
+		a.lastResponse.set(newResponseInfo(response))
+	}
+
+	body, err := bodyReader(response)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		errorBody, _ := ioutil.ReadAll(body)
+		return responseError(url, response, errorBody)
+	}
+	status = response.StatusCode
+	err = read(body)
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// newRequest returns a GET request for url with the client's headers.
+// Credentials are only added if url is on the API host, so that they
+// aren't leaked to other hosts, e.g. auction file URLs. The OAuth token
+// used, if any, is returned for invalidation on a 401.
+func (a *ApiClient) newRequest(ctx context.Context, url *url.URL) (*http.Request, string, error) {
+	request, err := http.NewRequestWithContext(ctx, "GET", url.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	request.Header.Set("User-Agent", a.userAgent())
+	// Setting this ourselves stops http.Transport from decompressing
+	// transparently, but means compression also works with transports
+	// that have DisableCompression set. bodyReader decompresses.
+	request.Header.Set("Accept-Encoding", "gzip")
+
+	var token string
+	if url.Host == a.apiHost() {
+		token, err = a.authorize(request)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	return request, token, nil
+}
+
+// responseError returns the error for a non-2xx response.
+func responseError(url *url.URL, response *http.Response, body []byte) error {
+	apiErr := &ApiError{StatusCode: response.StatusCode, Path: url.Path, Body: string(body)}
+	if response.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{apiErr, parseRetryAfter(response.Header.Get("Retry-After"))}
+	}
+	return apiErr
+}
+
+// emptyBodyError returns an error for a successful response without
+// content, which would otherwise surface as json's cryptic "unexpected
+// end of JSON input". Empty and whitespace-only bodies are told apart
+// for diagnosis.
+func emptyBodyError(url *url.URL, body []byte) error {
+	if len(body) == 0 {
+		return errors.New(fmt.Sprintf("Empty response from %s", url.Path))
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return errors.New(fmt.Sprintf("Blank response from %s: %d bytes of whitespace", url.Path, len(body)))
+	}
+	return nil
+}
+
+// readBody reads the response body, decompressing it if the server
+// gzipped it.
+func readBody(response *http.Response) ([]byte, error) {
+	body, err := bodyReader(response)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// bodyReader returns the response body, decompressing it if needed.
+// Closing it doesn't close response.Body.
+func bodyReader(response *http.Response) (io.ReadCloser, error) {
+	if !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		return ioutil.NopCloser(response.Body), nil
+	}
+	return gzip.NewReader(response.Body)
+}
+
+// authorize adds credentials to request: an OAuth bearer token if the
+// client has one, otherwise a signature if a public key is set. It
+// returns the bearer token used, if any.
+func (a *ApiClient) authorize(request *http.Request) (string, error) {
+	if a.tokens != nil {
+		token, err := a.tokens.token(request.Context(), a.httpClient())
+		if err != nil {
+			return "", err
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+		return token, nil
+	}
+	if len(a.PublicKey) > 0 && len(a.Secret) > 0 {
+		return "", a.sign(request)
+	}
+	return "", nil
+}
+
+// shouldRetry reports whether a failed request is worth repeating:
+// 5xx and 429 responses, per-request timeouts and network errors are;
+// other 4xx responses and a cancelled client context are not.
+func (a *ApiClient) shouldRetry(err error) bool {
+	if a.Context().Err() != nil {
+		return false
+	}
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return true
+	}
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500
+	}
+	var urlErr *url.Error
+	return errors.Is(err, context.DeadlineExceeded) || errors.As(err, &urlErr)
+}
+
+// retryDelay returns how long to wait before retrying after err. A 429
+// waits as long as the API asked; anything else backs off.
+func (a *ApiClient) retryDelay(err error, attempt int) time.Duration {
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return rateErr.RetryAfter
+	}
+	return a.backoff(attempt)
+}
+
+// backoff returns the delay before retry number attempt+1: the base
+// delay doubled for each previous attempt, with up to half of it
+// replaced by random jitter.
+func (a *ApiClient) backoff(attempt int) time.Duration {
+	delay := a.RetryBaseDelay
+	if delay <= 0 {
+		delay = DefaultRetryBaseDelay
+	}
+	delay <<= uint(attempt)
+	if delay <= 0 || delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	half := int64(delay / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
 func (a *ApiClient) url(path string, queryParamPairs map[string]string, ssl bool) *url.URL {
+	for k, v := range a.extraParams {
+		if _, ok := queryParamPairs[k]; !ok {
+			queryParamPairs[k] = v
+		}
+	}
 	queryParamPairs["locale"] = a.Locale
-	queryParamPairs["apikey"] = a.Secret
-	queryParamList := make([]string, 0)
+	if _, ok := queryParamPairs["namespace"]; !ok {
+		if kind := namespaceKind(path); kind != "" {
+			queryParamPairs["namespace"] = a.namespace(kind)
+		}
+	}
+	if len(a.Secret) > 0 {
+		queryParamPairs["apikey"] = a.Secret
+	}
+	query := url.Values{}
 	for k, v := range queryParamPairs {
-		queryParamList = append(queryParamList, k+"="+v)
+		query.Set(k, v)
 	}
 	var scheme string
 	if ssl {
@@ -509,29 +1747,121 @@ func (a *ApiClient) url(path string, queryParamPairs map[string]string, ssl bool
 	} else {
 		scheme = "http"
 	}
+	// Absolute paths address APIs outside the prefix, such as the game
+	// data API under /data/wow/.
+	if !strings.HasPrefix(path, "/") {
+		path = a.pathPrefix() + path
+	}
+	if a.BaseURL != nil {
+		scheme = a.BaseURL.Scheme
+		path = strings.TrimSuffix(a.BaseURL.EscapedPath(), "/") + path
+	}
+	// path arrives with its segments already escaped; keep that form as
+	// RawPath so that an escaped "/" in a name survives.
+	unescapedPath, err := url.PathUnescape(path)
+	if err != nil {
+		unescapedPath = path
+	}
 	return &url.URL{
 		Scheme:   scheme,
-		Host:     a.Host,
-		Path:     "/wow/" + path,
-		RawQuery: strings.Join(queryParamList, "&"),
+		Host:     a.apiHost(),
+		Path:     unescapedPath,
+		RawPath:  path,
+		RawQuery: query.Encode(),
+	}
+}
+
+// apiHost returns the host requests are sent to: BaseURL's if set,
+// otherwise Host.
+func (a *ApiClient) apiHost() string {
+	if a.BaseURL != nil {
+		return a.BaseURL.Host
+	}
+	return a.Host
+}
+
+// dynamicDataPaths are the game data API paths whose data changes
+// between patches, and so live in the dynamic namespace.
+var dynamicDataPaths = []string{
+	"/data/wow/auctions",
+	"/data/wow/connected-realm",
+	"/data/wow/mythic-challenge-mode",
+	"/data/wow/mythic-keystone",
+	"/data/wow/pvp-season",
+	"/data/wow/realm",
+	"/data/wow/region",
+	"/data/wow/token",
+}
+
+// communityPathKinds are the namespace kinds of the community API
+// paths, relative to the path prefix, that need one other than
+// "static", keyed by their first segment.
+var communityPathKinds = map[string]string{
+	"auction":         "dynamic",
+	"character":       "profile",
+	"connected-realm": "dynamic",
+	"guild":           "profile",
+	"realm":           "dynamic",
+}
+
+// namespaceKind returns the kind of namespace requests for path need:
+// "profile" for characters and guilds, "dynamic" for realm, auction
+// and other frequently changing game data, and "static" for the rest.
+// Relative paths are community API paths, classified by their first
+// segment; absolute paths outside the profile and game data APIs get
+// "", meaning none.
+func namespaceKind(path string) string {
+	switch {
+	case strings.HasPrefix(path, "/profile/"):
+		return "profile"
+	case strings.HasPrefix(path, "/data/"):
+		for _, prefix := range dynamicDataPaths {
+			if path == prefix || strings.HasPrefix(path, prefix+"/") {
+				return "dynamic"
+			}
+		}
+		return "static"
+	case !strings.HasPrefix(path, "/"):
+		segment := strings.SplitN(path, "/", 2)[0]
+		if kind, ok := communityPathKinds[segment]; ok {
+			return kind
+		}
+		return "static"
 	}
+	return ""
+}
+
+// namespace returns the game data API namespace of the given kind
+// ("static", "dynamic" or "profile") for the client's region.
+func (a *ApiClient) namespace(kind string) string {
+	return kind + "-" + strings.ToLower(string(a.Region))
 }
 
 func (a *ApiClient) authorizationString(signature string) string {
-	return fmt.Sprintf(" BNET %s:%s", a.PublicKey, signature)
+	return fmt.Sprintf("BNET %s:%s", a.PublicKey, signature)
 }
 
-func (a *ApiClient) signature(verb string, path string) string {
-	url := a.url(path, make(map[string]string), true)
-	toBeSigned := []byte(strings.Join([]string{verb, time.Now().String(), url.Path, ""}, "\n"))
+// signature signs a request as described in Blizzard's authentication
+// docs: the verb, the HTTP date sent in the Date header and the URL
+// path, each followed by a newline, HMAC-SHA1'd with the secret.
+func (a *ApiClient) signature(verb string, path string, date string) (string, error) {
+	toBeSigned := []byte(strings.Join([]string{verb, date, path, ""}, "\n"))
 	mac := hmac.New(sha1.New, []byte(a.Secret))
 	_, err := mac.Write(toBeSigned)
 	if err != nil {
-		handleError(err)
+		return "", err
 	}
-	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
 }
 
-func handleError(err error) {
-	panic(err)
+// sign adds the Date and Authorization headers for a signed request.
+func (a *ApiClient) sign(request *http.Request) error {
+	date := time.Now().UTC().Format(http.TimeFormat)
+	signature, err := a.signature(request.Method, request.URL.Path, date)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Date", date)
+	request.Header.Set("Authorization", a.authorizationString(signature))
+	return nil
 }
@@ -1,8 +1,21 @@
 package wow
 
 import (
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
 	. "launchpad.net/gocheck"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sync"
 	"testing"
+	"time"
 )
 
 // GoCheck boilerplate
@@ -14,31 +27,666 @@ var _ = Suite(&ApiClientSuite{})
 
 func (s *ApiClientSuite) Test_signature(c *C) {
 	client, _ := NewApiClient("US", "")
-	c.Assert(client.signature("GET", "a/b/c"), Not(Equals), "")
+	sig, err := client.signature("GET", "/wow/a/b/c", "Mon, 02 Jan 2006 15:04:05 GMT")
+	c.Assert(err, IsNil)
+	c.Assert(sig, Not(Equals), "")
+}
+
+func (s *ApiClientSuite) Test_signature_canonical(c *C) {
+	client := &ApiClient{Secret: "secret", PublicKey: "public"}
+	mac := hmac.New(sha1.New, []byte("secret"))
+	mac.Write([]byte("GET\nMon, 02 Jan 2006 15:04:05 GMT\n/wow/item/18803\n"))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	sig, err := client.signature("GET", "/wow/item/18803", "Mon, 02 Jan 2006 15:04:05 GMT")
+	c.Assert(err, IsNil)
+	c.Assert(sig, Equals, expected)
+	c.Assert(client.authorizationString(sig), Equals, "BNET public:"+expected)
+}
+
+func (s *ApiClientSuite) Test_sign(c *C) {
+	client := &ApiClient{Secret: "secret", PublicKey: "public"}
+	request, _ := http.NewRequest("GET", "https://us.api.battle.net/wow/item/18803", nil)
+	c.Assert(client.sign(request), IsNil)
+
+	date := request.Header.Get("Date")
+	_, err := time.Parse(time.RFC1123, date)
+	c.Assert(err, IsNil)
+	sig, _ := client.signature("GET", "/wow/item/18803", date)
+	c.Assert(request.Header.Get("Authorization"), Equals, "BNET public:"+sig)
 }
 
 func (s *ApiClientSuite) Test_NewApiClient_default(c *C) {
 	client, _ := NewApiClient("US", "")
-	c.Assert(client.Host, Equals, "us.battle.net")
+	c.Assert(client.Host, Equals, "us.api.battle.net")
 	c.Assert(client.Locale, Equals, "en_US")
 }
 
 func (s *ApiClientSuite) Test_NewApiClient_specific(c *C) {
 	client, _ := NewApiClient("EU", "fr_FR")
-	c.Assert(client.Host, Equals, "eu.battle.net")
+	c.Assert(client.Host, Equals, "eu.api.battle.net")
 	c.Assert(client.Locale, Equals, "fr_FR")
 }
 
+func (s *ApiClientSuite) Test_NewApiClient_normalizesLocale(c *C) {
+	client, err := NewApiClient("US", " en_us ")
+	c.Assert(err, IsNil)
+	c.Assert(client.Locale, Equals, "en_US")
+}
+
+func (s *ApiClientSuite) Test_SetLocale(c *C) {
+	client, _ := NewApiClient("EU", "")
+	c.Assert(client.SetLocale("de_de"), IsNil)
+	c.Assert(client.Locale, Equals, "de_DE")
+	err := client.SetLocale("en_US")
+	c.Assert(err.Error(), Equals, "Locale 'en_US' is not valid for region 'EU'. Valid locales are: en_GB, es_ES, fr_FR, ru_RU, de_DE, pt_PT, it_IT")
+	c.Assert(client.Locale, Equals, "de_DE")
+}
+
+func (s *ApiClientSuite) Test_NewApiClient_hosts(c *C) {
+	expected := map[Region]string{
+		RegionUS: "us.api.battle.net",
+		RegionEU: "eu.api.battle.net",
+		RegionKR: "kr.api.battle.net",
+		RegionTW: "tw.api.battle.net",
+		RegionCN: "api.battlenet.com.cn",
+	}
+	for region, host := range expected {
+		client, err := NewApiClient(region, "")
+		c.Assert(err, IsNil)
+		c.Assert(client.url("item/18803", map[string]string{}, true).Host, Equals, host)
+	}
+}
+
+func (s *ApiClientSuite) Test_NewApiClient_options(c *C) {
+	httpClient := &http.Client{}
+	client, _ := NewApiClient("US", "", WithSecret("key"), WithTimeout(time.Second), WithHttpClient(httpClient), WithUserAgent("test/1.0"))
+	c.Assert(client.Secret, Equals, "key")
+	c.Assert(client.Timeout, Equals, time.Second)
+	c.Assert(client.HttpClient, Equals, httpClient)
+	c.Assert(client.UserAgent, Equals, "test/1.0")
+}
+
+// recordingTransport records the paths of the requests it forwards.
+type recordingTransport struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+func (t *recordingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.paths = append(t.paths, request.URL.Path)
+	t.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func (s *ApiClientSuite) Test_HttpClient_used(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":18803}`))
+	}))
+	defer server.Close()
+
+	transport := &recordingTransport{}
+	client, _ := NewApiClient("US", "", WithHttpClient(&http.Client{Transport: transport}))
+	client.Host = server.Listener.Addr().String()
+	_, err := client.GetItem(18803)
+	c.Assert(err, IsNil)
+	c.Assert(transport.paths, DeepEquals, []string{"/wow/item/18803"})
+}
+
+func (s *ApiClientSuite) Test_UserAgent(c *C) {
+	agents := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.UserAgent())
+		w.Write([]byte(`{"id":18803}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	_, err := client.GetItem(18803)
+	c.Assert(err, IsNil)
+	custom, _ := NewApiClient("US", "", WithUserAgent("auction-scanner/2.3"))
+	custom.Host = server.Listener.Addr().String()
+	_, err = custom.GetItem(18803)
+	c.Assert(err, IsNil)
+	c.Assert(agents, DeepEquals, []string{DefaultUserAgent, "auction-scanner/2.3"})
+}
+
 func (s *ApiClientSuite) Test_NewApiClient_invalid(c *C) {
 	_, err := NewApiClient("China", "it_IT")
 	c.Assert(err.Error(), Equals, "Locale 'it_IT' is not valid for region 'China'")
 }
 
+func (s *ApiClientSuite) Test_ParseRegion(c *C) {
+	region, _ := ParseRegion("United States")
+	c.Assert(region, Equals, RegionUS)
+	region, _ = ParseRegion("ZH")
+	c.Assert(region, Equals, RegionCN)
+	client, _ := NewApiClient("Europe", "")
+	c.Assert(client.Region, Equals, RegionEU)
+}
+
+func (s *ApiClientSuite) Test_WithLocale(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":18803,"name":"` + r.URL.Query().Get("locale") + `"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("EU", "")
+	client.Host = server.Listener.Addr().String()
+	german, err := client.WithLocale("de_de")
+	c.Assert(err, IsNil)
+	item, err := german.GetItem(18803)
+	c.Assert(err, IsNil)
+	c.Assert(item.Name, Equals, "de_DE")
+
+	item, err = client.GetItemLocale(18803, "fr_FR")
+	c.Assert(err, IsNil)
+	c.Assert(item.Name, Equals, "fr_FR")
+	item, err = client.GetItem(18803)
+	c.Assert(err, IsNil)
+	c.Assert(item.Name, Equals, "en_GB")
+
+	_, err = client.WithLocale("en_US")
+	c.Assert(err, NotNil)
+	_, err = client.GetItemLocale(18803, "en_US")
+	c.Assert(err, ErrorMatches, "Locale 'en_US' is not valid for region 'EU'.*")
+}
+
+func (s *ApiClientSuite) Test_SetCurrentApiClient(c *C) {
+	previous := CurrentApiClient()
+	defer SetCurrentApiClient(previous)
+
+	SetCurrentApiClient(nil)
+	client, _ := NewApiClient("US", "")
+	c.Assert(CurrentApiClient(), IsNil)
+	SetCurrentApiClient(client)
+	c.Assert(CurrentApiClient(), Equals, client)
+	_, _ = NewApiClient("EU", "")
+	c.Assert(CurrentApiClient(), Equals, client)
+}
+
+func (s *ApiClientSuite) Test_LocalesForRegion(c *C) {
+	locale, err := DefaultLocaleForRegion("Europe")
+	c.Assert(err, IsNil)
+	c.Assert(locale, Equals, "en_GB")
+	locales, err := ValidLocalesForRegion("KR")
+	c.Assert(err, IsNil)
+	c.Assert(locales, DeepEquals, []string{"ko_KR"})
+	_, err = ValidLocalesForRegion("Notaregion")
+	c.Assert(err, ErrorMatches, "Region 'Notaregion' is not valid")
+}
+
 func (s *ApiClientSuite) Test_NewApiClient_invalidRegion(c *C) {
 	_, err := NewApiClient("Notaregion", "")
 	c.Assert(err.Error(), Equals, "Region 'Notaregion' is not valid")
 }
 
+func (s *ApiClientSuite) Test_WithContext_cancelled(c *C) {
+	client, _ := NewApiClient("US", "")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.WithContext(ctx).GetAchievement(2144)
+	c.Assert(err, Equals, context.Canceled)
+	c.Assert(client.Context(), Equals, context.Background())
+}
+
+func (s *ApiClientSuite) Test_getWithParams_retries(c *C) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	client.MaxRetries = 2
+	client.RetryBaseDelay = time.Millisecond
+	a, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(a.Id, Equals, 2144)
+	c.Assert(calls, Equals, 3)
+}
+
+func (s *ApiClientSuite) Test_getWithParams_retryAfter(c *C) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 2 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	client.MaxRetries = 1
+	client.RetryBaseDelay = time.Millisecond
+	start := time.Now()
+	a, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(a.Id, Equals, 2144)
+	c.Assert(calls, Equals, 2)
+	c.Assert(time.Since(start) >= time.Second, Equals, true)
+}
+
+func (s *ApiClientSuite) Test_getWithParams_timeout(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/item/1" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		// Headers and part of the body arrive at once, then the body
+		// stalls.
+		w.Write([]byte(`{"id":`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.Write([]byte(`18803}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "", WithTimeout(50*time.Millisecond))
+	client.Host = server.Listener.Addr().String()
+	start := time.Now()
+	_, err := client.GetItem(1)
+	c.Assert(err, Equals, context.DeadlineExceeded)
+	_, err = client.GetItem(18803)
+	c.Assert(err, Equals, context.DeadlineExceeded)
+	c.Assert(time.Since(start) < 500*time.Millisecond, Equals, true)
+}
+
+func (s *ApiClientSuite) Test_getWithParams_emptyBody(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/achievement/2" {
+			w.Write([]byte(" \n"))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	_, err := client.GetAchievement(1)
+	c.Assert(err, ErrorMatches, "Empty response from /wow/achievement/1")
+	_, err = client.GetAchievement(2)
+	c.Assert(err, ErrorMatches, "Blank response from /wow/achievement/2: 2 bytes of whitespace")
+}
+
+func (s *ApiClientSuite) Test_getWithParams_logger(c *C) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	defer server.Close()
+
+	events := make([]*RequestEvent, 0)
+	client, _ := NewApiClient("US", "", WithRetries(1, time.Millisecond), WithLogger(LoggerFunc(func(event *RequestEvent) {
+		events = append(events, event)
+	})))
+	client.Host = server.Listener.Addr().String()
+	_, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 2)
+	c.Assert(events[0].Path, Equals, "/wow/achievement/2144")
+	c.Assert(events[0].StatusCode, Equals, http.StatusServiceUnavailable)
+	c.Assert(events[0].Err, NotNil)
+	c.Assert(events[1].StatusCode, Equals, http.StatusOK)
+	c.Assert(events[1].Attempt, Equals, 1)
+	c.Assert(events[1].Err, IsNil)
+}
+
+func (s *ApiClientSuite) Test_getWithParams_metrics(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/item/1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	endpoints := make([]string, 0)
+	statuses := make([]int, 0)
+	client, _ := NewApiClient("US", "", WithMetrics(func(endpoint string, statusCode int, elapsed time.Duration) {
+		endpoints = append(endpoints, endpoint)
+		statuses = append(statuses, statusCode)
+	}))
+	client.Host = server.Listener.Addr().String()
+	client.GetCharacter("Argent Dawn", "Capoferro")
+	client.GetItem(1)
+	client.GetMythicKeystoneLeaderboard(11, 197, 641)
+	c.Assert(endpoints, DeepEquals, []string{
+		"character/:realm/:name",
+		"item/:id",
+		"/data/wow/connected-realm/:id/mythic-leaderboard/:id/period/:id",
+	})
+	c.Assert(statuses, DeepEquals, []int{http.StatusOK, http.StatusNotFound, http.StatusOK})
+}
+
+func (s *ApiClientSuite) Test_getWithParams_noRetryOn404(c *C) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	client.MaxRetries = 2
+	_, err := client.GetAchievement(1)
+	c.Assert(err.(*ApiError).StatusCode, Equals, http.StatusNotFound)
+	c.Assert(calls, Equals, 1)
+}
+
+func (s *ApiClientSuite) Test_getWithParams_cache(c *C) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "", WithCache(NewMemoryCache(), time.Minute))
+	client.Host = server.Listener.Addr().String()
+	client.GetAchievement(2144)
+	client.GetAchievement(2144)
+	c.Assert(calls, Equals, 1)
+	client.WithoutCache().GetAchievement(2144)
+	c.Assert(calls, Equals, 2)
+}
+
+func (s *ApiClientSuite) Test_getWithParams_conditional(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"realms":[{"name":"Runetotem"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "", WithConditionalRequests())
+	client.Host = server.Listener.Addr().String()
+	client.GetRealmStatus()
+	a, err := client.GetRealmStatus()
+	c.Assert(err, IsNil)
+	c.Assert(a[0].Name, Equals, "Runetotem")
+	c.Assert(client.CacheStats(), Equals, CacheStats{Misses: 1, NotModified: 1})
+}
+
+func (s *ApiClientSuite) Test_getWithParams_gzip(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Header.Get("Accept-Encoding"), Equals, "gzip")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`{"id":2144}`))
+		gz.Close()
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(a.Id, Equals, 2144)
+}
+
+func (s *ApiClientSuite) Test_LastResponseInfo(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Plan-Quota-Allotted", "36000")
+		w.Header().Set("X-Plan-Quota-Current", "12")
+		w.Header().Set("X-Plan-Qps-Allotted", "100")
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	c.Assert(client.LastResponseInfo(), IsNil)
+	_, err := client.WithContext(context.Background()).GetAchievement(2144)
+	c.Assert(err, IsNil)
+	info := client.LastResponseInfo()
+	c.Assert(info.StatusCode, Equals, http.StatusOK)
+	c.Assert(info.QuotaAllotted, Equals, 36000)
+	c.Assert(info.QuotaCurrent, Equals, 12)
+	c.Assert(info.QpsAllotted, Equals, 100)
+	c.Assert(info.QpsCurrent, Equals, 0)
+}
+
+func (s *ApiClientSuite) Test_url_baseURL(c *C) {
+	base, _ := url.Parse("https://localhost:8443/mock/")
+	client, _ := NewApiClient("US", "", WithBaseURL(base))
+	u := client.url("item/18803", map[string]string{}, false)
+	c.Assert(u.Scheme, Equals, "https")
+	c.Assert(u.Host, Equals, "localhost:8443")
+	c.Assert(u.Path, Equals, "/mock/wow/item/18803")
+}
+
+func (s *ApiClientSuite) Test_url_escapedPath(c *C) {
+	client, _ := NewApiClient("US", "")
+	u := client.url("character/Argent%20Dawn/Ca%2Fr", map[string]string{}, false)
+	c.Assert(u.Path, Equals, "/wow/character/Argent Dawn/Ca/r")
+	c.Assert(u.EscapedPath(), Equals, "/wow/character/Argent%20Dawn/Ca%2Fr")
+}
+
+func (s *ApiClientSuite) Test_url_escapedQuery(c *C) {
+	client, _ := NewApiClient("US", "en_US", WithSecret("a&b=c"))
+	u := client.url("item/18803", map[string]string{"fields": "a b&c=d"}, true)
+	c.Assert(u.RawQuery, Equals, "apikey=a%26b%3Dc&fields=a+b%26c%3Dd&locale=en_US&namespace=static-us")
+	c.Assert(u.Query().Get("fields"), Equals, "a b&c=d")
+}
+
+func (s *ApiClientSuite) Test_url_namespace(c *C) {
+	client, _ := NewApiClient("EU", "")
+	namespace := func(path string, params map[string]string) string {
+		return client.url(path, params, true).Query().Get("namespace")
+	}
+	c.Assert(namespace("/data/wow/item/18803", map[string]string{}), Equals, "static-eu")
+	c.Assert(namespace("/data/wow/connected-realm/11", map[string]string{}), Equals, "dynamic-eu")
+	c.Assert(namespace("/data/wow/token/index", map[string]string{}), Equals, "dynamic-eu")
+	c.Assert(namespace("/data/wow/realms", map[string]string{}), Equals, "static-eu")
+	c.Assert(namespace("/profile/wow/character/argent-dawn/capoferro", map[string]string{}), Equals, "profile-eu")
+	c.Assert(namespace("item/18803", map[string]string{}), Equals, "static-eu")
+	c.Assert(namespace("data/character/classes", map[string]string{}), Equals, "static-eu")
+	c.Assert(namespace("character/argent-dawn/Capoferro", map[string]string{}), Equals, "profile-eu")
+	c.Assert(namespace("guild/argent-dawn/Wipes", map[string]string{}), Equals, "profile-eu")
+	c.Assert(namespace("realm/status", map[string]string{}), Equals, "dynamic-eu")
+	c.Assert(namespace("auction/data/argent-dawn", map[string]string{}), Equals, "dynamic-eu")
+	c.Assert(namespace("/oauth/token", map[string]string{}), Equals, "")
+	c.Assert(namespace("/data/wow/item/18803", map[string]string{"namespace": "static-8.0.1_27101-eu"}), Equals, "static-8.0.1_27101-eu")
+}
+
+func (s *ApiClientSuite) Test_url_pathPrefix(c *C) {
+	client, _ := NewApiClient("US", "")
+	c.Assert(client.url("item/18803", map[string]string{}, true).Path, Equals, "/wow/item/18803")
+	client.PathPrefix = "sc2"
+	c.Assert(client.url("profile/1/2/Name", map[string]string{}, true).Path, Equals, "/sc2/profile/1/2/Name")
+	c.Assert(client.url("/data/wow/token/index", map[string]string{}, true).Path, Equals, "/data/wow/token/index")
+	client, _ = NewApiClient("US", "", WithPathPrefix("/d3/"))
+	c.Assert(client.url("data/act", map[string]string{}, true).Path, Equals, "/d3/data/act")
+}
+
+func (s *ApiClientSuite) Test_url_queryParams(c *C) {
+	client, _ := NewApiClient("US", "", WithSecret("key"))
+	extra := client.WithQueryParams(map[string]string{"fields": "items", "locale": "de_DE", "apikey": "other", "extra": "1"})
+	extra = extra.WithQueryParams(map[string]string{"more": "2"})
+	query := extra.url("character/runetotem/Capoferro", map[string]string{"fields": "stats"}, true).Query()
+	c.Assert(query.Get("extra"), Equals, "1")
+	c.Assert(query.Get("more"), Equals, "2")
+	c.Assert(query.Get("fields"), Equals, "stats")
+	c.Assert(query.Get("locale"), Equals, "en_US")
+	c.Assert(query.Get("apikey"), Equals, "key")
+	c.Assert(client.url("achievement/2144", map[string]string{}, true).Query().Get("extra"), Equals, "")
+}
+
+func (s *ApiClientSuite) Test_url_noSecret(c *C) {
+	client, _ := NewApiClient("US", "en_US")
+	u := client.url("item/18803", map[string]string{}, false)
+	c.Assert(u.RawQuery, Equals, "locale=en_US&namespace=static-us")
+}
+
+func (s *ApiClientSuite) Test_GetCharacter_escapesPath(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.EscapedPath(), Equals, "/wow/character/argent-dawn/M%C3%BCller")
+		w.Write([]byte(`{"name":"M\u00fcller","realm":"Argent Dawn"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("EU", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetCharacter("Argent Dawn", "Müller")
+	c.Assert(err, IsNil)
+	c.Assert(a.Name, Equals, "Müller")
+}
+
+func (s *ApiClientSuite) Test_CharacterExists(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/character/runetotem/Capoferro":
+			w.Write([]byte(`{"name":"Capoferro"}`))
+		case "/wow/character/runetotem/Nobody":
+			http.NotFound(w, r)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	exists, err := client.CharacterExists("Runetotem", "Capoferro")
+	c.Assert(err, IsNil)
+	c.Assert(exists, Equals, true)
+	exists, err = client.CharacterExists("Runetotem", "Nobody")
+	c.Assert(err, IsNil)
+	c.Assert(exists, Equals, false)
+	_, err = client.CharacterExists("Runetotem", "Broken")
+	c.Assert(err.(*ApiError).StatusCode, Equals, http.StatusInternalServerError)
+}
+
+func (s *ApiClientSuite) Test_GetGuild_escapesPath(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.EscapedPath(), Equals, "/wow/guild/argent-dawn/The%20Rats")
+		w.Write([]byte(`{"name":"The Rats","realm":"Argent Dawn"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("EU", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetGuild("Argent Dawn", "The Rats")
+	c.Assert(err, IsNil)
+	c.Assert(a.Name, Equals, "The Rats")
+}
+
+func (s *ApiClientSuite) Test_backoff(c *C) {
+	client := &ApiClient{RetryBaseDelay: 100 * time.Millisecond}
+	d := client.backoff(2)
+	c.Assert(d >= 200*time.Millisecond && d <= 400*time.Millisecond, Equals, true)
+}
+
+func (s *ApiClientSuite) Test_StrictDecoding(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":2144,"newField":true}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(a.Id, Equals, 2144)
+
+	client.StrictDecoding = true
+	_, err = client.GetAchievement(2144)
+	c.Assert(err, ErrorMatches, `json: unknown field "newField"`)
+	_, err = client.GetItem(2144)
+	c.Assert(err, ErrorMatches, `json: unknown field "newField"`)
+}
+
+func (s *ApiClientSuite) Test_GetResource(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/data/wow/mount/6")
+		c.Check(r.URL.Query().Get("namespace"), Equals, "static-us")
+		w.Write([]byte(`{"id":6,"name":"Brown Horse"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	var mount struct {
+		Id   int
+		Name string
+	}
+	err := client.GetResource("/data/wow/mount/6", &mount)
+	c.Assert(err, IsNil)
+	c.Assert(mount.Id, Equals, 6)
+	c.Assert(mount.Name, Equals, "Brown Horse")
+}
+
+func (s *ApiClientSuite) Test_GetRaw(c *C) {
+	body := `{"name":"Capoferro","realm":"Runetotem","titles":[{"id":42,"name":"%s the Explorer","selected":true}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/character/runetotem/Capoferro")
+		c.Check(r.URL.Query().Get("fields"), Equals, "titles")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	params := map[string]string{"fields": "titles"}
+	raw, err := client.GetRaw("character/runetotem/Capoferro", params)
+	c.Assert(err, IsNil)
+	c.Assert(string(raw), Equals, body)
+	c.Assert(params, DeepEquals, map[string]string{"fields": "titles"})
+}
+
+func (s *ApiClientSuite) Test_RawJSON(c *C) {
+	itemBody := `{"id":18803,"name":"Finkle's Lava Dredger","quality":4}`
+	characterBody := `{"name":"Capoferro","realm":"Runetotem","level":90}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/item/18803" {
+			w.Write([]byte(itemBody))
+			return
+		}
+		c.Check(r.URL.Path, Equals, "/wow/character/runetotem/Capoferro")
+		w.Write([]byte(characterBody))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	item, err := client.GetItem(18803)
+	c.Assert(err, IsNil)
+	c.Assert(item.Name, Equals, "Finkle's Lava Dredger")
+	c.Assert(string(item.RawJSON), Equals, itemBody)
+	char, err := client.GetCharacter("Runetotem", "Capoferro")
+	c.Assert(err, IsNil)
+	c.Assert(char.Level, Equals, 90)
+	c.Assert(string(char.RawJSON), Equals, characterBody)
+}
+
 func (s *ApiClientSuite) Test_GetAchievement(c *C) {
 	client, _ := NewApiClient("US", "")
 	a, _ := client.GetAchievement(2144)
@@ -49,12 +697,204 @@ func (s *ApiClientSuite) Test_GetAchievement(c *C) {
 	c.Assert(len(a.RewardItems), Equals, 1)
 }
 
+func (s *ApiClientSuite) Test_AchievementById(c *C) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"achievements":[{"id":92,"name":"General","achievements":[{"id":6,"title":"Level 10"}],"categories":[{"id":14,"name":"Quests","achievements":[{"id":92,"title":"Fed"}]}]}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.AchievementById(6)
+	c.Assert(err, IsNil)
+	c.Assert(a.Title, Equals, "Level 10")
+	a, err = client.AchievementById(92)
+	c.Assert(err, IsNil)
+	c.Assert(a.Title, Equals, "Fed")
+	_, err = client.AchievementById(14)
+	c.Assert(err, ErrorMatches, "Achievement 14 not found")
+	c.Assert(calls, Equals, 1)
+
+	c.Assert(client.RefreshAchievements(), IsNil)
+	c.Assert(calls, Equals, 2)
+}
+
 func (s *ApiClientSuite) Test_GetAuctionData(c *C) {
 	client, _ := NewApiClient("US", "")
 	a, _ := client.GetAuctionData("Runetotem")
 	c.Assert(len(a.Files), Equals, 1)
 }
 
+func (s *ApiClientSuite) Test_GetAuctionDump(c *C) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auctions.json" {
+			w.Write([]byte(`{"realms":[{"name":"Runetotem","slug":"runetotem"}],"auctions":[{"auc":1,"item":18803,"owner":"Capoferro","buyout":100,"quantity":1,"timeLeft":"LONG"}]}`))
+			return
+		}
+		w.Write([]byte(`{"files":[{"url":"` + server.URL + `/auctions.json","lastModified":1400000000000}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetAuctionDump("Runetotem")
+	c.Assert(err, IsNil)
+	c.Assert(a.LastModified, Equals, int64(1400000000000))
+	c.Assert(a.Realms[0].Slug, Equals, "runetotem")
+	c.Assert(len(a.Auctions), Equals, 1)
+	c.Assert(a.Auctions[0].Item, Equals, 18803)
+	c.Assert(a.Auctions[0].TimeLeft, Equals, "LONG")
+}
+
+func (s *ApiClientSuite) Test_GetAuctionDump_instrumented(c *C) {
+	downloads := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auction-data/0f3ce2f2/auctions.json" {
+			downloads++
+			w.Write([]byte(`{"auctions":[{"auc":1,"item":18803}]}`))
+			return
+		}
+		w.Write([]byte(`{"files":[{"url":"` + server.URL + `/auction-data/0f3ce2f2/auctions.json","lastModified":1400000000000}]}`))
+	}))
+	defer server.Close()
+
+	endpoints := make([]string, 0)
+	client, _ := NewApiClient("US", "", WithCache(NewMemoryCache(), time.Minute), WithMetrics(func(endpoint string, statusCode int, elapsed time.Duration) {
+		endpoints = append(endpoints, endpoint)
+	}))
+	client.Host = server.Listener.Addr().String()
+	for i := 0; i < 2; i++ {
+		a, err := client.GetAuctionDump("Runetotem")
+		c.Assert(err, IsNil)
+		c.Assert(a.Auctions, HasLen, 1)
+	}
+	c.Assert(downloads, Equals, 1)
+	c.Assert(endpoints, DeepEquals, []string{"auction/data/:realm", "auction/data/file", "auction/data/:realm", "auction/data/file"})
+	c.Assert(client.CacheStats(), Equals, CacheStats{Hits: 2, Misses: 2})
+}
+
+func (s *ApiClientSuite) Test_GetAuctionDumpSince(c *C) {
+	var server *httptest.Server
+	downloads := 0
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auctions.json" {
+			downloads++
+			w.Write([]byte(`{"realms":[{"name":"Runetotem","slug":"runetotem"}],"auctions":[{"auc":1,"item":18803}]}`))
+			return
+		}
+		w.Write([]byte(`{"files":[{"url":"` + server.URL + `/auctions.json","lastModified":1400000000000}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+
+	changed, err := client.HasNewAuctionData("Runetotem", 1400000000000)
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, false)
+	a, err := client.GetAuctionDumpSince("Runetotem", 1400000000000)
+	c.Assert(err, Equals, ErrAuctionDataNotModified)
+	c.Assert(a, IsNil)
+	c.Assert(downloads, Equals, 0)
+
+	changed, err = client.HasNewAuctionData("Runetotem", 1399999999999)
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, true)
+	a, err = client.GetAuctionDumpSince("Runetotem", 1399999999999)
+	c.Assert(err, IsNil)
+	c.Assert(a.LastModified, Equals, int64(1400000000000))
+	c.Assert(a.Auctions[0].Item, Equals, 18803)
+	c.Assert(downloads, Equals, 1)
+}
+
+func (s *ApiClientSuite) Test_StreamAuctions(c *C) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auctions.json" {
+			w.Write([]byte(`{"realms":[{"name":"Runetotem","slug":"runetotem"}],"auctions":[{"auc":1,"item":18803,"buyout":100},{"auc":2,"item":18804,"buyout":200},{"auc":3,"item":18805,"buyout":300}]}`))
+			return
+		}
+		w.Write([]byte(`{"files":[{"url":"` + server.URL + `/auctions.json","lastModified":1400000000000}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	items := make([]int, 0)
+	err := client.StreamAuctions("Runetotem", func(auction *Auction) error {
+		items = append(items, auction.Item)
+		return nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(items, DeepEquals, []int{18803, 18804, 18805})
+
+	stop := errors.New("stop")
+	count := 0
+	err = client.StreamAuctions("Runetotem", func(auction *Auction) error {
+		count++
+		if auction.Auc == 2 {
+			return stop
+		}
+		return nil
+	})
+	c.Assert(err, Equals, stop)
+	c.Assert(count, Equals, 2)
+}
+
+func (s *ApiClientSuite) Test_StreamAuctions_timeout(c *C) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow-body/auctions.json":
+			w.Write([]byte(`{"auctions":[{"auc":1,"item":18803},`))
+			w.(http.Flusher).Flush()
+			time.Sleep(100 * time.Millisecond)
+			w.Write([]byte(`{"auc":2,"item":18804}]}`))
+		case "/slow-headers/auctions.json":
+			time.Sleep(100 * time.Millisecond)
+			w.Write([]byte(`{"auctions":[]}`))
+		default:
+			w.Write([]byte(`{"files":[{"url":"` + server.URL + `/` + path.Base(r.URL.Path) + `/auctions.json"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	events := make([]*RequestEvent, 0)
+	endpoints := make([]string, 0)
+	client, _ := NewApiClient("US", "", WithTimeout(50*time.Millisecond),
+		WithLogger(LoggerFunc(func(event *RequestEvent) {
+			events = append(events, event)
+		})),
+		WithMetrics(func(endpoint string, statusCode int, elapsed time.Duration) {
+			endpoints = append(endpoints, endpoint)
+		}))
+	client.Host = server.Listener.Addr().String()
+
+	items := make([]int, 0)
+	err := client.StreamAuctions("slow-body", func(auction *Auction) error {
+		items = append(items, auction.Item)
+		return nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(items, DeepEquals, []int{18803, 18804})
+	c.Assert(events, HasLen, 2)
+	c.Assert(events[1].Path, Equals, "/slow-body/auctions.json")
+	c.Assert(events[1].StatusCode, Equals, http.StatusOK)
+	c.Assert(events[1].Duration >= 100*time.Millisecond, Equals, true)
+	c.Assert(endpoints, DeepEquals, []string{"auction/data/:realm", auctionFileEndpoint})
+
+	err = client.StreamAuctions("slow-headers", func(auction *Auction) error {
+		return nil
+	})
+	c.Assert(err, Equals, context.DeadlineExceeded)
+	c.Assert(events[len(events)-1].Err, Equals, context.DeadlineExceeded)
+	c.Assert(events[len(events)-1].StatusCode, Equals, 0)
+}
+
 func (s *ApiClientSuite) Test_GetBattlePetAbility(c *C) {
 	client, _ := NewApiClient("US", "")
 	a, _ := client.GetBattlePetAbility(640)
@@ -68,6 +908,27 @@ func (s *ApiClientSuite) Test_GetBattlePetAbility(c *C) {
 	c.Assert(a.ShowHints, Equals, false)
 }
 
+func (s *ApiClientSuite) Test_GetBattlePetAbilities(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/battlePet/ability/1" {
+			http.NotFound(w, r)
+			return
+		}
+		c.Check(path.Dir(r.URL.Path), Equals, "/wow/battlePet/ability")
+		w.Write([]byte(`{"id":` + path.Base(r.URL.Path) + `,"name":"Toxic Smoke","petTypeId":9}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetBattlePetAbilities([]int{640, 1})
+	c.Assert(len(a), Equals, 1)
+	c.Assert(a[640].Name, Equals, "Toxic Smoke")
+	c.Assert(a[640].PetTypeId, Equals, 9)
+	batchErr := err.(*BatchError)
+	c.Assert(batchErr.Errors[1].(*ApiError).StatusCode, Equals, http.StatusNotFound)
+}
+
 func (s *ApiClientSuite) Test_GetBattlePetSpecies(c *C) {
 	client, _ := NewApiClient("US", "")
 	a, _ := client.GetBattlePetSpecies(258)
@@ -95,6 +956,53 @@ func (s *ApiClientSuite) Test_GetBattlePetStats(c *C) {
 	c.Assert(a.Speed, Equals, 297)
 }
 
+func (s *ApiClientSuite) Test_GetBattlePet_invalid(c *C) {
+	client, _ := NewApiClient("US", "")
+	_, err := client.GetBattlePet(258, 26, 5, 4)
+	c.Assert(err, ErrorMatches, "Battle pet level 26 is not valid, expected 1-25")
+	_, err = client.GetBattlePet(258, 25, 2, 4)
+	c.Assert(err, ErrorMatches, "Battle pet breed id 2 is not valid, expected 3-22")
+	_, err = client.GetBattlePet(258, 25, 5, 6)
+	c.Assert(err, ErrorMatches, "Battle pet quality id 6 is not valid, expected 0-5")
+}
+
+const bossFixture = `{"id":24723,"name":"Selin Fireheart","urlSlug":"selin-fireheart","zoneId":4131,"availableInNormalMode":true,"availableInHeroicMode":true,"health":82230,"heroicHealth":131568,"level":72,"heroicLevel":72,"journalId":530,"npcs":[{"id":24723,"name":"Selin Fireheart","urlSlug":"selin-fireheart","creatureDisplayId":22642}]}`
+
+func (s *ApiClientSuite) Test_GetBoss(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/boss/24723")
+		w.Write([]byte(bossFixture))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetBoss(24723)
+	c.Assert(err, IsNil)
+	c.Assert(a.Name, Equals, "Selin Fireheart")
+	c.Assert(a.ZoneId, Equals, 4131)
+	c.Assert(a.AvailableInHeroicMode, Equals, true)
+	c.Assert(a.HeroicHealth, Equals, 131568)
+	c.Assert(len(a.Npcs), Equals, 1)
+	c.Assert(a.Npcs[0].CreatureDisplayId, Equals, 22642)
+}
+
+func (s *ApiClientSuite) Test_GetBosses(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/boss/")
+		w.Write([]byte(`{"bosses":[` + bossFixture + `,{"id":24744,"name":"Vexallus","zoneId":4131}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetBosses()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 2)
+	c.Assert(a[0].JournalId, Equals, 530)
+	c.Assert(a[1].Name, Equals, "Vexallus")
+}
+
 func (s *ApiClientSuite) Test_GetChallenges(c *C) {
 	client, _ := NewApiClient("US", "")
 	a, _ := client.GetChallenges("Runetotem")
@@ -183,6 +1091,177 @@ func (s *ApiClientSuite) Test_GetItem2(c *C) {
 	c.Assert(len(a.Stats), Equals, 0)
 }
 
+func (s *ApiClientSuite) Test_GetItems(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/item/1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"id":` + path.Base(r.URL.Path) + `}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetItems([]int{18803, 1, 104426})
+	c.Assert(len(a), Equals, 2)
+	c.Assert(a[18803].Id, Equals, 18803)
+	c.Assert(a[104426].Id, Equals, 104426)
+	batchErr := err.(*BatchError)
+	c.Assert(len(batchErr.Errors), Equals, 1)
+	c.Assert(batchErr.Errors[1].(*ApiError).StatusCode, Equals, http.StatusNotFound)
+}
+
+func (s *ApiClientSuite) Test_SearchItems(c *C) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/data/wow/search/item")
+		c.Check(r.URL.Query().Get("namespace"), Equals, "static-eu")
+		c.Check(r.URL.Query().Get("name.de_DE"), Equals, "Donnerzorn")
+		w.Write([]byte(`{"page":1,"results":[{"key":{"href":"https://eu.api.blizzard.com/data/wow/item/19019"},"data":{"id":19019,"name":{"en_GB":"Thunderfury","de_DE":"Donnerzorn"},"level":80,"required_level":60,"quality":{"type":"LEGENDARY"},"item_class":{"id":2},"item_subclass":{"id":7},"is_equippable":true}}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("EU", "", WithSecret("secret"), WithHttpClient(server.Client()))
+	client.Host = server.Listener.Addr().String()
+	a, err := client.SearchItemsLocale("Donnerzorn", "de_DE")
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 1)
+	c.Assert(a[0].Id, Equals, 19019)
+	c.Assert(a[0].Name, Equals, "Donnerzorn")
+	c.Assert(a[0].Quality, Equals, 5)
+	c.Assert(a[0].ItemSubclass, Equals, 7)
+	c.Assert(a[0].Equipable, Equals, true)
+}
+
+func (s *ApiClientSuite) Test_SearchItems_strict(c *C) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"page":1,"pageSize":100,"maxPageSize":1000,"pageCount":1,"results":[{"key":{"href":"https://us.api.blizzard.com/data/wow/item/19019?namespace=static-us"},"data":{"level":80,"required_level":60,"sell_price":255355,"item_subclass":{"name":{"en_US":"Sword"},"id":7},"is_equippable":true,"purchase_quantity":1,"media":{"id":19019},"item_class":{"name":{"en_US":"Weapon"},"id":2},"quality":{"name":{"en_US":"Legendary"},"type":"LEGENDARY"},"max_count":1,"is_stackable":false,"name":{"en_US":"Thunderfury, Blessed Blade of the Windseeker"},"purchase_price":1021420,"id":19019,"inventory_type":{"name":{"en_US":"One-Hand"},"type":"WEAPON"}}}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "", WithSecret("secret"), WithHttpClient(server.Client()), WithStrictDecoding())
+	client.Host = server.Listener.Addr().String()
+	a, err := client.SearchItems("Thunderfury")
+	c.Assert(err, IsNil)
+	c.Assert(a, HasLen, 1)
+	c.Assert(a[0].Name, Equals, "Thunderfury, Blessed Blade of the Windseeker")
+	c.Assert(a[0].BuyPrice, Equals, 1021420)
+}
+
+func (s *ApiClientSuite) Test_SearchItems_noCredentials(c *C) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.SearchItems("Thunderfury")
+	c.Assert(a, IsNil)
+	c.Assert(err, ErrorMatches, "Item search needs OAuth credentials or a Secret.*")
+	c.Assert(calls, Equals, 0)
+}
+
+func (s *ApiClientSuite) Test_ItemName(c *C) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		names := map[string]string{"en_GB": "Finkle's Lava Dredger", "de_DE": "Finkles Lavabagger"}
+		w.Write([]byte(`{"id":18803,"name":"` + names[r.URL.Query().Get("locale")] + `"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("EU", "")
+	client.Host = server.Listener.Addr().String()
+	for i := 0; i < 2; i++ {
+		name, err := client.ItemName(18803, "de_de")
+		c.Assert(err, IsNil)
+		c.Assert(name, Equals, "Finkles Lavabagger")
+		name, err = client.ItemName(18803, "en_GB")
+		c.Assert(err, IsNil)
+		c.Assert(name, Equals, "Finkle's Lava Dredger")
+	}
+	c.Assert(calls, Equals, 2)
+	_, err := client.ItemName(18803, "ko_KR")
+	c.Assert(err, NotNil)
+}
+
+func (s *ApiClientSuite) Test_connectionReuse(c *C) {
+	var mu sync.Mutex
+	dials := 0
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":` + path.Base(r.URL.Path) + `}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mu.Lock()
+			dials++
+			mu.Unlock()
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "", WithBatchConcurrency(4))
+	client.Host = server.Listener.Addr().String()
+	for i := 0; i < 5; i++ {
+		_, err := client.GetItem(18803)
+		c.Assert(err, IsNil)
+	}
+	mu.Lock()
+	c.Assert(dials, Equals, 1)
+	mu.Unlock()
+
+	ids := make([]int, 0, 16)
+	for id := 1; id <= 16; id++ {
+		ids = append(ids, id)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := client.GetItems(ids)
+		c.Assert(err, IsNil)
+	}
+	mu.Lock()
+	c.Assert(dials <= 4, Equals, true)
+	mu.Unlock()
+}
+
+func (s *ApiClientSuite) Test_GetSpells(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/spell/1" {
+			http.NotFound(w, r)
+			return
+		}
+		c.Check(path.Dir(r.URL.Path), Equals, "/wow/spell")
+		w.Write([]byte(`{"id":` + path.Base(r.URL.Path) + `,"icon":"spell_nature_lightning"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetSpells([]int{8056, 1})
+	c.Assert(len(a), Equals, 1)
+	c.Assert(a[8056].IconURL(client.Region, IconLarge), Equals, "https://render-us.worldofwarcraft.com/icons/56/spell_nature_lightning.jpg")
+	batchErr := err.(*BatchError)
+	c.Assert(batchErr.Errors[1].(*ApiError).StatusCode, Equals, http.StatusNotFound)
+}
+
+func (s *ApiClientSuite) Test_GetItemWithBonusLists(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Query().Get("bl"), Equals, "566,1808")
+		w.Write([]byte(`{"id":113602,"context":"raid-heroic","bonusLists":[566,1808],"bonusSummary":{"defaultBonusLists":[],"chanceBonusLists":[566],"bonusChances":[{"chanceType":"SOCKET","sockets":[{"socketType":"PRISMATIC"}]}]}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetItemWithBonusLists(113602, []int{566, 1808})
+	c.Assert(err, IsNil)
+	c.Assert(a.BonusLists, DeepEquals, []int{566, 1808})
+	c.Assert(a.Context, Equals, "raid-heroic")
+	c.Assert(a.BonusSummary.BonusChances[0].Sockets[0].SocketType, Equals, "PRISMATIC")
+}
+
 func (s *ApiClientSuite) Test_GetItemSet(c *C) {
 	client, _ := NewApiClient("US", "")
 
@@ -192,6 +1271,47 @@ func (s *ApiClientSuite) Test_GetItemSet(c *C) {
 	c.Assert(len(a.SetBonuses), Equals, 2)
 }
 
+func (s *ApiClientSuite) Test_GetItemSets(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/item/set/1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"id":` + path.Base(r.URL.Path) + `,"items":[76749,76750],"setBonuses":[{"description":"4 piece","threshold":4},{"description":"2 piece","threshold":2}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetItemSets([]int{1060, 1})
+	c.Assert(len(a), Equals, 1)
+	batchErr := err.(*BatchError)
+	c.Assert(batchErr.Errors[1].(*ApiError).StatusCode, Equals, http.StatusNotFound)
+	set := a[1060]
+	c.Assert(set.HasItem(76750), Equals, true)
+	c.Assert(set.HasItem(1), Equals, false)
+	c.Assert(set.Bonuses()[0].Threshold, Equals, 2)
+	c.Assert(set.ActiveBonuses(3), HasLen, 1)
+	c.Assert(set.ActiveBonuses(4), HasLen, 2)
+
+	items, err := set.ResolveItems(client)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Assert(items[0].Id, Equals, 76749)
+	c.Assert(items[1].Id, Equals, 76750)
+
+	previous := CurrentApiClient()
+	defer SetCurrentApiClient(previous)
+	var nilClient *ApiClient
+	SetCurrentApiClient(nil)
+	_, err = set.ResolveItems(nilClient)
+	c.Assert(err, ErrorMatches, "No API client given.*")
+	SetCurrentApiClient(client)
+	items, err = set.ResolveItems(nilClient)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+}
+
 func (s *ApiClientSuite) Test_GetGuild(c *C) {
 	client, _ := NewApiClient("US", "")
 
@@ -214,6 +1334,57 @@ func (s *ApiClientSuite) Test_GetPvPLeaderboard(c *C) {
 	c.Assert(len(a) > 0, Equals, true)
 }
 
+func (s *ApiClientSuite) Test_GetPvPLeaderboard_invalidBracket(c *C) {
+	client, _ := NewApiClient("US", "")
+	_, err := client.GetPvPLeaderboard("3V3")
+	c.Assert(err, ErrorMatches, "Bracket '3V3' is not valid, expected one of \\[2v2 3v3 5v5 rbg\\]")
+}
+
+func (s *ApiClientSuite) Test_GetPvPLeaderboardRange(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/leaderboard/3v3")
+		w.Write([]byte(`{"rows":[{"ranking":1},{"ranking":2},{"ranking":3},{"ranking":4}],"other":{"a":[1]}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetPvPLeaderboardRange("3v3", 1, 2)
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 2)
+	c.Assert(a[0].Ranking, Equals, 2)
+	c.Assert(a[1].Ranking, Equals, 3)
+
+	a, err = client.GetPvPLeaderboardRange("3v3", 3, 0)
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 1)
+
+	a, err = client.GetPvPLeaderboardRange("3v3", 10, 5)
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 0)
+
+	_, err = client.GetPvPLeaderboardRange("3v3", -1, 5)
+	c.Assert(err, ErrorMatches, "Invalid leaderboard offset -1")
+}
+
+func (s *ApiClientSuite) Test_GetMythicKeystoneLeaderboard(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/data/wow/connected-realm/11/mythic-leaderboard/197/period/641")
+		w.Write([]byte(`{"name":"Eye of Azshara","period":641,"leading_groups":[{"ranking":1,"duration":1500000,"keystone_level":15,"members":[{"profile":{"name":"Capoferro","realm":{"id":11,"slug":"tichondrius"}},"faction":{"type":"HORDE"},"specialization":{"id":250}}]}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetMythicKeystoneLeaderboard(11, 197, 641)
+	c.Assert(err, IsNil)
+	group := a.LeadingGroups[0]
+	c.Assert(group.KeystoneLevel, Equals, 15)
+	c.Assert(group.Time(), Equals, 25*time.Minute)
+	c.Assert(group.Members[0].Profile.Realm.Slug, Equals, "tichondrius")
+	c.Assert(group.Members[0].Specialization.Id, Equals, 250)
+}
+
 func (s *ApiClientSuite) Test_GetQuest(c *C) {
 	client, _ := NewApiClient("US", "")
 
@@ -225,6 +1396,25 @@ func (s *ApiClientSuite) Test_GetQuest(c *C) {
 	c.Assert(a.SuggestedPartyMembers, Equals, 0)
 }
 
+func (s *ApiClientSuite) Test_GetQuests(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/quest/1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"id":` + path.Base(r.URL.Path) + `}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetQuests([]int{13146, 1, 13147})
+	c.Assert(len(a), Equals, 2)
+	c.Assert(a[13147].Id, Equals, 13147)
+	batchErr := err.(*BatchError)
+	c.Assert(batchErr.Errors[1].(*ApiError).StatusCode, Equals, http.StatusNotFound)
+}
+
 func (s *ApiClientSuite) Test_GetRealmStatus(c *C) {
 	client, _ := NewApiClient("US", "")
 
@@ -235,6 +1425,123 @@ func (s *ApiClientSuite) Test_GetRealmStatus(c *C) {
 	c.Assert(len(a) > 0, Equals, true)
 }
 
+func (s *ApiClientSuite) Test_GetRealmStatusFor(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/realm/status")
+		c.Check(r.URL.Query().Get("realms"), Equals, "runetotem,argent-dawn")
+		w.Write([]byte(`{"realms":[{"name":"Runetotem","slug":"runetotem"},{"name":"Argent Dawn","slug":"argent-dawn"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetRealmStatusFor("runetotem", "argent-dawn")
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 2)
+	c.Assert(a[1].Slug, Equals, "argent-dawn")
+}
+
+func (s *ApiClientSuite) Test_GetTokenPrice(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/data/wow/token/index")
+		c.Check(r.URL.Query().Get("namespace"), Equals, "dynamic-eu")
+		w.Write([]byte(`{"last_updated_timestamp":1540000000000,"price":2345670000}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("EU", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetTokenPrice()
+	c.Assert(err, IsNil)
+	c.Assert(a.Gold(), Equals, int64(234567))
+	c.Assert(a.LastUpdated().Equal(time.Unix(1540000000, 0)), Equals, true)
+}
+
+func (s *ApiClientSuite) Test_GetConnectedRealmAuctions(c *C) {
+	realmCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Query().Get("namespace"), Equals, "dynamic-us")
+		switch r.URL.Path {
+		case "/data/wow/realm/argent-dawn":
+			realmCalls++
+			w.Write([]byte(`{"id":75,"slug":"argent-dawn","connected_realm":{"href":"https://us.api.blizzard.com/data/wow/connected-realm/3693?namespace=dynamic-us"}}`))
+		case "/data/wow/connected-realm/3693":
+			w.Write([]byte(`{"id":3693,"realms":[{"id":75,"slug":"argent-dawn"},{"id":1071,"slug":"the-scryers"}]}`))
+		case "/data/wow/connected-realm/3693/auctions":
+			w.Write([]byte(`{"auctions":[{"id":1,"item":{"id":18803,"context":3},"bid":100,"buyout":200,"quantity":1,"time_left":"LONG"},{"id":2,"item":{"id":2589},"unit_price":15,"quantity":20,"time_left":"SHORT"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetConnectedRealmAuctions("Argent Dawn")
+	c.Assert(err, IsNil)
+	c.Assert(a.ConnectedRealm.Slugs(), DeepEquals, []string{"argent-dawn", "the-scryers"})
+	c.Assert(a.Auctions, HasLen, 2)
+	c.Assert(*a.Auctions[0], Equals, Auction{Auc: 1, Item: 18803, Bid: 100, Buyout: 200, Quantity: 1, TimeLeft: "LONG", Context: 3})
+	c.Assert(a.Auctions[1].Buyout, Equals, 15)
+
+	_, err = client.GetConnectedRealmAuctions("argent-dawn")
+	c.Assert(err, IsNil)
+	c.Assert(realmCalls, Equals, 1)
+}
+
+func (s *ApiClientSuite) Test_GetConnectedRealms(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Query().Get("namespace"), Equals, "dynamic-us")
+		switch r.URL.Path {
+		case "/data/wow/connected-realm/index":
+			w.Write([]byte(`{"connected_realms":[{"href":"https://us.api.blizzard.com/data/wow/connected-realm/11?namespace=dynamic-us"}]}`))
+		case "/data/wow/connected-realm/11":
+			w.Write([]byte(`{"id":11,"has_queue":false,"status":{"type":"UP","name":"Up"},"realms":[{"id":11,"name":"Tichondrius","slug":"tichondrius"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetConnectedRealms()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 1)
+	c.Assert(a[0].Id, Equals, 11)
+	c.Assert(a[0].IsOnline(), Equals, true)
+	c.Assert(a[0].Slugs(), DeepEquals, []string{"tichondrius"})
+}
+
+func (s *ApiClientSuite) Test_GetConnectedRealmAuctions_strict(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/data/wow/realm/argent-dawn":
+			w.Write([]byte(`{"_links":{"self":{"href":"https://us.api.blizzard.com/data/wow/realm/75?namespace=dynamic-us"}},"id":75,"region":{"key":{"href":"https://us.api.blizzard.com/data/wow/region/1?namespace=dynamic-us"},"name":"North America","id":1},"connected_realm":{"href":"https://us.api.blizzard.com/data/wow/connected-realm/3693?namespace=dynamic-us"},"name":"Argent Dawn","category":"United States","locale":"enUS","timezone":"America/New_York","type":{"type":"RP","name":"Roleplaying"},"is_tournament":false,"slug":"argent-dawn"}`))
+		case "/data/wow/connected-realm/index":
+			w.Write([]byte(`{"_links":{"self":{"href":"https://us.api.blizzard.com/data/wow/connected-realm/?namespace=dynamic-us"}},"connected_realms":[{"href":"https://us.api.blizzard.com/data/wow/connected-realm/3693?namespace=dynamic-us"}]}`))
+		case "/data/wow/connected-realm/3693":
+			w.Write([]byte(`{"id":3693,"has_queue":false,"status":{"type":"UP","name":"Up"},"population":{"type":"FULL","name":"Full"},"realms":[{"id":75,"name":"Argent Dawn","slug":"argent-dawn","category":"United States","locale":"enUS","timezone":"America/New_York","type":{"type":"RP","name":"Roleplaying"},"is_tournament":false}]}`))
+		case "/data/wow/connected-realm/3693/auctions":
+			w.Write([]byte(`{"_links":{"self":{"href":"https://us.api.blizzard.com/data/wow/connected-realm/3693/auctions?namespace=dynamic-us"}},"connected_realm":{"href":"https://us.api.blizzard.com/data/wow/connected-realm/3693?namespace=dynamic-us"},"auctions":[{"id":1,"item":{"id":18803,"context":3,"modifiers":[{"type":9,"value":60}]},"bid":100,"buyout":200,"quantity":1,"time_left":"LONG"}],"commodities":{"href":"https://us.api.blizzard.com/data/wow/auctions/commodities?namespace=dynamic-us"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "", WithStrictDecoding())
+	client.Host = server.Listener.Addr().String()
+	ids, err := client.GetConnectedRealmIds()
+	c.Assert(err, IsNil)
+	c.Assert(ids, DeepEquals, []int{3693})
+	a, err := client.GetConnectedRealmAuctions("Argent Dawn")
+	c.Assert(err, IsNil)
+	c.Assert(a.ConnectedRealm.Id, Equals, 3693)
+	c.Assert(a.Auctions, HasLen, 1)
+	c.Assert(a.Auctions[0].Item, Equals, 18803)
+}
+
 func (s *ApiClientSuite) Test_GetRecipe(c *C) {
 	client, _ := NewApiClient("US", "")
 
@@ -246,6 +1553,26 @@ func (s *ApiClientSuite) Test_GetRecipe(c *C) {
 	c.Assert(a.Profession, Equals, "Enchanting")
 }
 
+func (s *ApiClientSuite) Test_GetRecipes(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/recipe/1" {
+			http.NotFound(w, r)
+			return
+		}
+		c.Check(path.Dir(r.URL.Path), Equals, "/wow/recipe")
+		w.Write([]byte(`{"id":` + path.Base(r.URL.Path) + `,"icon":"spell_holy_greaterheal"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetRecipes([]int{33994, 1})
+	c.Assert(len(a), Equals, 1)
+	c.Assert(a[33994].IconURL(client.Region, IconMedium), Equals, "https://render-us.worldofwarcraft.com/icons/36/spell_holy_greaterheal.jpg")
+	batchErr := err.(*BatchError)
+	c.Assert(batchErr.Errors[1].(*ApiError).StatusCode, Equals, http.StatusNotFound)
+}
+
 func (s *ApiClientSuite) Test_GetSpell(c *C) {
 	client, _ := NewApiClient("US", "")
 
@@ -257,6 +1584,43 @@ func (s *ApiClientSuite) Test_GetSpell(c *C) {
 	c.Assert(a.Cooldown, Equals, "6 sec cooldown")
 }
 
+const zoneFixture = `{"id":4131,"name":"Magisters' Terrace","urlSlug":"magisters-terrace","location":{"id":4080,"name":"Isle of Quel'Danas"},"expansionId":1,"numPlayers":"5","isDungeon":true,"isRaid":false,"advisedMinLevel":68,"advisedMaxLevel":72,"availableModes":["DUNGEON_NORMAL","DUNGEON_HEROIC"],"floors":2,"patch":"2.4","bosses":[{"id":24723,"name":"Selin Fireheart"},{"id":24744,"name":"Vexallus"}]}`
+
+func (s *ApiClientSuite) Test_GetZone(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/zone/4131")
+		w.Write([]byte(zoneFixture))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetZone(4131)
+	c.Assert(err, IsNil)
+	c.Assert(a.Name, Equals, "Magisters' Terrace")
+	c.Assert(a.IsDungeon, Equals, true)
+	c.Assert(a.Location.Name, Equals, "Isle of Quel'Danas")
+	c.Assert(a.AvailableModes, DeepEquals, []string{"DUNGEON_NORMAL", "DUNGEON_HEROIC"})
+	c.Assert(a.NumBosses(), Equals, 2)
+	c.Assert(a.Bosses[1].Name, Equals, "Vexallus")
+}
+
+func (s *ApiClientSuite) Test_GetZones(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/zone/")
+		w.Write([]byte(`{"zones":[` + zoneFixture + `,{"id":3845,"name":"Tempest Keep","isRaid":true}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetZones()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 2)
+	c.Assert(a[0].Patch, Equals, "2.4")
+	c.Assert(a[1].IsRaid, Equals, true)
+}
+
 func (s *ApiClientSuite) Test_GetBattlegroups(c *C) {
 	client, _ := NewApiClient("US", "")
 
@@ -338,6 +1702,24 @@ func (s *ApiClientSuite) Test_GetItemClasses(c *C) {
 	c.Assert(len(a[0].Subclasses) > 0, Equals, true)
 }
 
+func (s *ApiClientSuite) Test_TalentsForClass(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"6":{"class":"death-knight","glyphs":[{"glyph":512,"name":"Glyph of Icy Touch"}],"specs":[{"name":"Blood","role":"TANK"},{"name":"Frost","role":"DPS"}],"talents":[[{"tier":0,"column":0,"spell":{"id":123693}}]]}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.TalentsForClass(6)
+	c.Assert(err, IsNil)
+	c.Assert(a.Class, Equals, "death-knight")
+	c.Assert(len(a.Specs), Equals, 2)
+	c.Assert(a.Glyphs[0].Name, Equals, "Glyph of Icy Touch")
+	c.Assert(a.Talents[0][0].Spell.Id, Equals, 123693)
+	_, err = client.TalentsForClass(1)
+	c.Assert(err, ErrorMatches, "No talents for class 1")
+}
+
 func (s *ApiClientSuite) Test_GetTalents(c *C) {
 	client, _ := NewApiClient("US", "")
 
@@ -361,3 +1743,22 @@ func (s *ApiClientSuite) Test_GetPetTypes(c *C) {
 	c.Assert(len(a) > 0, Equals, true)
 }
 
+func (s *ApiClientSuite) Test_GetMounts(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/mount/")
+		w.Write([]byte(`{"mounts":[{"name":"Brown Horse","spellId":458,"creatureId":284,"itemId":5656,"qualityId":1,"icon":"ability_mount_ridinghorse","isGroundMount":true,"isFlyingMount":false,"isAquatic":false,"isJumping":true}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	a, err := client.GetMounts()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 1)
+	c.Assert(a[0].Name, Equals, "Brown Horse")
+	c.Assert(a[0].SpellId, Equals, 458)
+	c.Assert(a[0].ItemId, Equals, 5656)
+	c.Assert(a[0].IsGroundMount, Equals, true)
+	c.Assert(a[0].IsFlyingMount, Equals, false)
+}
+
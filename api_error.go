@@ -0,0 +1,60 @@
+package wow
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ApiError is returned when the API responds with a non-2xx status
+// code. Path is the request path that failed and Body holds the raw
+// response body for debugging. Use errors.As to inspect it:
+//
+//	var apiErr *ApiError
+//	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+//		...
+//	}
+type ApiError struct {
+	StatusCode int
+	Path       string
+	Body       string
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("%s: %d %s", e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// DefaultRetryAfter is used for RateLimitError.RetryAfter when a 429
+// response carries no usable Retry-After header.
+const DefaultRetryAfter = time.Second
+
+// RateLimitError is returned when the API responds with 429 Too Many
+// Requests. RetryAfter is how long the API asked us to wait before
+// trying again.
+type RateLimitError struct {
+	*ApiError
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s (retry after %s)", e.ApiError.Error(), e.RetryAfter)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.ApiError
+}
+
+// parseRetryAfter reads a Retry-After header value, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+	return DefaultRetryAfter
+}
@@ -0,0 +1,29 @@
+package wow
+
+import (
+	"errors"
+	. "launchpad.net/gocheck"
+	"time"
+)
+
+type ApiErrorSuite struct{}
+
+var _ = Suite(&ApiErrorSuite{})
+
+func (s *ApiErrorSuite) Test_Error(c *C) {
+	err := &ApiError{StatusCode: 404, Path: "/wow/item/999999999"}
+	c.Assert(err.Error(), Equals, "/wow/item/999999999: 404 Not Found")
+}
+
+func (s *ApiErrorSuite) Test_RateLimitError_unwrap(c *C) {
+	var err error = &RateLimitError{&ApiError{StatusCode: 429}, time.Second}
+	var apiErr *ApiError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, 429)
+}
+
+func (s *ApiErrorSuite) Test_parseRetryAfter(c *C) {
+	c.Assert(parseRetryAfter("5"), Equals, 5*time.Second)
+	c.Assert(parseRetryAfter(""), Equals, DefaultRetryAfter)
+	c.Assert(parseRetryAfter("soon"), Equals, DefaultRetryAfter)
+}
@@ -0,0 +1,15 @@
+package wow
+
+type Auction struct {
+	Auc        int
+	Item       int
+	Owner      string
+	OwnerRealm string
+	Bid        int
+	Buyout     int
+	Quantity   int
+	TimeLeft   string
+	Rand       int
+	Seed       int
+	Context    int
+}
@@ -3,3 +3,15 @@ package wow
 type AuctionData struct {
 	Files []*AuctionDataFiles
 }
+
+// LastModified returns the newest timestamp of the referenced files,
+// in milliseconds since the epoch.
+func (a *AuctionData) LastModified() int64 {
+	var lastModified int64
+	for _, file := range a.Files {
+		if file.LastModified > lastModified {
+			lastModified = file.LastModified
+		}
+	}
+	return lastModified
+}
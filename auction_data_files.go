@@ -1,6 +1,6 @@
 package wow
 
 type AuctionDataFiles struct {
-	LastModified uint
+	LastModified int64
 	Url          string
 }
@@ -0,0 +1,17 @@
+package wow
+
+import (
+	"errors"
+)
+
+// ErrAuctionDataNotModified is returned by GetAuctionDumpSince when the
+// auction data hasn't changed.
+var ErrAuctionDataNotModified = errors.New("Auction data has not been modified")
+
+// AuctionDump is the contents of the auction house files referenced by
+// AuctionData.
+type AuctionDump struct {
+	LastModified int64
+	Realms       []*Realm
+	Auctions     []*Auction
+}
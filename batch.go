@@ -0,0 +1,95 @@
+package wow
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const DefaultBatchConcurrency = 8
+
+// BatchError is returned by the batch methods, such as GetItems, when
+// some of the requests failed. Errors is keyed by id; results for the
+// other ids are still returned.
+type BatchError struct {
+	Errors map[int]error
+}
+
+func (e *BatchError) Error() string {
+	ids := make([]int, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	messages := make([]string, 0, len(ids))
+	for _, id := range ids {
+		messages = append(messages, fmt.Sprintf("%d: %s", id, e.Errors[id]))
+	}
+	return fmt.Sprintf("%d requests failed: %s", len(ids), strings.Join(messages, "; "))
+}
+
+func (a *ApiClient) batchConcurrency() int {
+	if a.BatchConcurrency > 0 {
+		return a.BatchConcurrency
+	}
+	return DefaultBatchConcurrency
+}
+
+// batch calls fetch for each distinct id from a pool of
+// BatchConcurrency goroutines, so fetch must be safe to call
+// concurrently. It returns a *BatchError if any call failed.
+func (a *ApiClient) batch(ids []int, fetch func(id int) error) error {
+	queue := make(chan int)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(map[int]error)
+
+	for i := 0; i < a.batchConcurrency(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				if err := fetch(id); err != nil {
+					mu.Lock()
+					errs[id] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			queue <- id
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return &BatchError{errs}
+	}
+	return nil
+}
+
+// batchGet fetches each distinct id with get, as batch does, and
+// collects the results by id. Ids whose fetch failed are left out of
+// the map and reported in the returned *BatchError.
+func batchGet[T any](a *ApiClient, ids []int, get func(id int) (T, error)) (map[int]T, error) {
+	results := make(map[int]T, len(ids))
+	var mu sync.Mutex
+	err := a.batch(ids, func(id int) error {
+		result, err := get(id)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		results[id] = result
+		mu.Unlock()
+		return nil
+	})
+	return results, err
+}
@@ -0,0 +1,49 @@
+package wow
+
+import (
+	"errors"
+	. "launchpad.net/gocheck"
+	"sync"
+	"time"
+)
+
+type BatchSuite struct{}
+
+var _ = Suite(&BatchSuite{})
+
+func (s *BatchSuite) Test_batchGet(c *C) {
+	client, _ := NewApiClient("US", "", WithBatchConcurrency(2))
+	var mu sync.Mutex
+	calls := make(map[int]int)
+	running, maxRunning := 0, 0
+	get := func(id int) (string, error) {
+		mu.Lock()
+		calls[id]++
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		if id < 0 {
+			return "", errors.New("bad id")
+		}
+		return "result", nil
+	}
+
+	results, err := batchGet(client, []int{1, 2, -3, 1, 4, 5}, get)
+	c.Assert(results, DeepEquals, map[int]string{1: "result", 2: "result", 4: "result", 5: "result"})
+	c.Assert(calls, DeepEquals, map[int]int{1: 1, 2: 1, -3: 1, 4: 1, 5: 1})
+	c.Assert(maxRunning <= 2, Equals, true)
+	batchErr, ok := err.(*BatchError)
+	c.Assert(ok, Equals, true)
+	c.Assert(batchErr.Errors, HasLen, 1)
+	c.Assert(batchErr, ErrorMatches, "1 requests failed: -3: bad id")
+
+	results, err = batchGet(client, []int{4, 5}, get)
+	c.Assert(err, IsNil)
+	c.Assert(results, HasLen, 2)
+}
@@ -1,5 +1,10 @@
 package wow
 
+import (
+	"errors"
+	"fmt"
+)
+
 type BattlePet struct {
 	BreedId      int
 	Health       int
@@ -9,3 +14,27 @@ type BattlePet struct {
 	SpeciesId    int
 	Speed        int
 }
+
+// Valid arguments to GetBattlePet. Breeds 3-12 are the male variants
+// and 13-22 the female ones; qualities run from poor to legendary.
+const (
+	MinBattlePetLevel   = 1
+	MaxBattlePetLevel   = 25
+	MinBattlePetBreed   = 3
+	MaxBattlePetBreed   = 22
+	MinBattlePetQuality = 0
+	MaxBattlePetQuality = 5
+)
+
+func validateBattlePet(level int, breedId int, qualityId int) error {
+	if level < MinBattlePetLevel || level > MaxBattlePetLevel {
+		return errors.New(fmt.Sprintf("Battle pet level %d is not valid, expected %d-%d", level, MinBattlePetLevel, MaxBattlePetLevel))
+	}
+	if breedId < MinBattlePetBreed || breedId > MaxBattlePetBreed {
+		return errors.New(fmt.Sprintf("Battle pet breed id %d is not valid, expected %d-%d", breedId, MinBattlePetBreed, MaxBattlePetBreed))
+	}
+	if qualityId < MinBattlePetQuality || qualityId > MaxBattlePetQuality {
+		return errors.New(fmt.Sprintf("Battle pet quality id %d is not valid, expected %d-%d", qualityId, MinBattlePetQuality, MaxBattlePetQuality))
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package wow
+
+type BonusChance struct {
+	ChanceType string
+	Upgrade    *BonusUpgrade
+	Stats      []*BonusStat
+	Sockets    []*BonusSocket
+}
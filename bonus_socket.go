@@ -0,0 +1,5 @@
+package wow
+
+type BonusSocket struct {
+	SocketType string
+}
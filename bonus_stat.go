@@ -0,0 +1,6 @@
+package wow
+
+type BonusStat struct {
+	StatId string
+	Delta  int
+}
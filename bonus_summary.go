@@ -0,0 +1,7 @@
+package wow
+
+type BonusSummary struct {
+	DefaultBonusLists []int
+	ChanceBonusLists  []int
+	BonusChances      []*BonusChance
+}
@@ -0,0 +1,7 @@
+package wow
+
+type BonusUpgrade struct {
+	UpgradeType string
+	Name        string
+	Id          int
+}
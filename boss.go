@@ -0,0 +1,17 @@
+package wow
+
+type Boss struct {
+	Id                    int
+	Name                  string
+	UrlSlug               string
+	Description           string
+	ZoneId                int
+	AvailableInNormalMode bool
+	AvailableInHeroicMode bool
+	Health                int
+	HeroicHealth          int
+	Level                 int
+	HeroicLevel           int
+	JournalId             int
+	Npcs                  []*Npc
+}
@@ -0,0 +1,5 @@
+package wow
+
+type bossList struct {
+	Bosses []*Boss
+}
@@ -0,0 +1,27 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Bracket identifies a rated PvP leaderboard.
+type Bracket string
+
+const (
+	Bracket2v2 Bracket = "2v2"
+	Bracket3v3 Bracket = "3v3"
+	Bracket5v5 Bracket = "5v5"
+	BracketRBG Bracket = "rbg"
+)
+
+var validBrackets = []Bracket{Bracket2v2, Bracket3v3, Bracket5v5, BracketRBG}
+
+func validateBracket(bracket Bracket) error {
+	for _, valid := range validBrackets {
+		if bracket == valid {
+			return nil
+		}
+	}
+	return errors.New(fmt.Sprintf("Bracket '%s' is not valid, expected one of %v", bracket, validBrackets))
+}
@@ -6,3 +6,15 @@ type BracketList struct {
 	ArenaBracket5v5 *ArenaBracket `json:"ARENA_BRACKET_5v5"`
 	ArenaBracketRBG *ArenaBracket `json:"ARENA_BRACKET_RBG"`
 }
+
+// All returns the brackets that are present, in 2v2, 3v3, 5v5, RBG
+// order.
+func (b *BracketList) All() []*ArenaBracket {
+	brackets := make([]*ArenaBracket, 0, 4)
+	for _, bracket := range []*ArenaBracket{b.ArenaBracket2v2, b.ArenaBracket3v3, b.ArenaBracket5v5, b.ArenaBracketRBG} {
+		if bracket != nil {
+			brackets = append(brackets, bracket)
+		}
+	}
+	return brackets
+}
@@ -0,0 +1,110 @@
+package wow
+
+import (
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const DefaultCacheTTL = time.Hour
+
+// Cache stores response bodies keyed by request. Implement it to back
+// the client with Redis, memcached and the like; NewMemoryCache
+// provides an in-process implementation. Implementations must be safe
+// for concurrent use.
+type Cache interface {
+	// Get returns the value stored under key, if it hasn't expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value under key for ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-memory Cache.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]*memoryCacheEntry
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]*memoryCacheEntry)}
+}
+
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = &memoryCacheEntry{value, time.Now().Add(ttl)}
+}
+
+// cacheKey identifies a request by host, path and query, leaving out
+// the API key so it isn't written to external caches.
+func cacheKey(u *url.URL) string {
+	query := u.Query()
+	query.Del("apikey")
+	return u.Host + u.Path + "?" + query.Encode()
+}
+
+// CacheStats counts how requests were answered. Hits were served from
+// Cache without a request, NotModified were revalidated with a 304
+// and Misses were fetched in full.
+type CacheStats struct {
+	Hits        int64
+	NotModified int64
+	Misses      int64
+}
+
+type cacheCounters struct {
+	hits        int64
+	notModified int64
+	misses      int64
+}
+
+// The counting methods do nothing on a nil *cacheCounters, so clients
+// built without NewApiClient don't need one.
+func (c *cacheCounters) hit() {
+	if c != nil {
+		atomic.AddInt64(&c.hits, 1)
+	}
+}
+
+func (c *cacheCounters) revalidated() {
+	if c != nil {
+		atomic.AddInt64(&c.notModified, 1)
+	}
+}
+
+func (c *cacheCounters) miss() {
+	if c != nil {
+		atomic.AddInt64(&c.misses, 1)
+	}
+}
+
+func (c *cacheCounters) stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	return CacheStats{
+		Hits:        atomic.LoadInt64(&c.hits),
+		NotModified: atomic.LoadInt64(&c.notModified),
+		Misses:      atomic.LoadInt64(&c.misses),
+	}
+}
@@ -5,3 +5,18 @@ type Challenge struct {
 	Map    *Map
 	Groups []*ChallengeGroup
 }
+
+// BestGroup returns the group with the fastest time, or nil if there
+// are no groups.
+func (c *Challenge) BestGroup() *ChallengeGroup {
+	var best *ChallengeGroup
+	for _, group := range c.Groups {
+		if group.Time == nil {
+			continue
+		}
+		if best == nil || group.Time.Duration() < best.Time.Duration() {
+			best = group
+		}
+	}
+	return best
+}
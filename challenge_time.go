@@ -1,5 +1,7 @@
 package wow
 
+import "time"
+
 type ChallengeTime struct {
 	Time         int
 	Hours        int
@@ -8,3 +10,9 @@ type ChallengeTime struct {
 	Milliseconds int
 	IsPositive   bool
 }
+
+// Duration returns the time as a time.Duration. Time is in
+// milliseconds.
+func (c *ChallengeTime) Duration() time.Duration {
+	return time.Duration(c.Time) * time.Millisecond
+}
@@ -3,8 +3,15 @@ package wow
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
+// Character is a character profile. Accessors such as CollectedMounts
+// fetch their section of the profile through ApiClient if the
+// character was retrieved without it. Each section is fetched at most
+// once, even if the API leaves it out of the response, as it does for
+// sections that are empty.
 type Character struct {
 	AchievementPoints   int
 	Battlegroup         string
@@ -29,6 +36,7 @@ type Character struct {
 	Talents             []*CharacterTalentList
 	Appearance          *CharacterAppearance
 	Mounts              *MountList
+	HunterPets          []*HunterPet
 	Pets                *PetList
 	PetSlots            []*PetSlot
 	Progression         *ProgressionList
@@ -36,6 +44,11 @@ type Character struct {
 	Quests              []int
 	TotalHonorableKills int
 	ApiClient           *ApiClient
+	// loaded holds the fields fetched for the character so far.
+	loaded map[string]bool
+	// RawJSON is the most recent response the character was decoded
+	// from.
+	RawJSON []byte `json:"-"`
 }
 
 func NewCharacter(client *ApiClient) *Character {
@@ -68,3 +81,344 @@ func (c *Character) Class() (string, error) {
 	return c.class, nil
 
 }
+
+// LastModifiedTime returns LastModified, which the API gives in
+// milliseconds since the epoch, as a time.Time. It is the zero time if
+// LastModified is not set.
+func (c *Character) LastModifiedTime() time.Time {
+	if c.LastModified == 0 {
+		return time.Time{}
+	}
+	return msToTime(int64(c.LastModified))
+}
+
+// load fetches the given fields for the character from the API and
+// fills them in, for accessors of sections that weren't requested
+// when the character was first retrieved.
+func (c *Character) load(fields ...string) error {
+	if c.ApiClient == nil {
+		return errors.New(fmt.Sprintf("Character instance does not have an ApiClient reference. Please set ApiClient before loading %v.", fields))
+	}
+	return c.ApiClient.loadCharacter(c, c.Realm, c.Name, fields)
+}
+
+// ensure loads field unless present is set or the field has been
+// loaded before.
+func (c *Character) ensure(field string, present bool) error {
+	if present || c.loaded[field] {
+		return nil
+	}
+	return c.load(field)
+}
+
+// markLoaded records that fields have been fetched for the character.
+func (c *Character) markLoaded(fields []string) {
+	if c.loaded == nil {
+		c.loaded = make(map[string]bool, len(fields))
+	}
+	for _, field := range fields {
+		c.loaded[field] = true
+	}
+}
+
+// ActivityFeed returns the character's recent activity, newest first.
+func (c *Character) ActivityFeed() ([]*FeedEntry, error) {
+	err := c.ensure("feed", c.Feed != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Feed == nil {
+		return make([]*FeedEntry, 0), nil
+	}
+	return c.Feed, nil
+}
+
+// FeedOfType returns the entries of the activity feed of any of the
+// given types, such as FeedLoot. Unlike ActivityFeed it doesn't fetch
+// anything.
+func (c *Character) FeedOfType(types ...string) []*FeedEntry {
+	feed := make([]*FeedEntry, 0)
+	for _, entry := range c.Feed {
+		for _, t := range types {
+			if entry.Type == t {
+				feed = append(feed, entry)
+				break
+			}
+		}
+	}
+	return feed
+}
+
+// CollectedMounts returns the character's mount collection.
+func (c *Character) CollectedMounts() (*MountList, error) {
+	err := c.ensure("mounts", c.Mounts != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Mounts == nil {
+		return &MountList{}, nil
+	}
+	return c.Mounts, nil
+}
+
+// CollectedPets returns the character's battle pet collection.
+func (c *Character) CollectedPets() (*PetList, error) {
+	err := c.ensure("pets", c.Pets != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Pets == nil {
+		return &PetList{}, nil
+	}
+	return c.Pets, nil
+}
+
+// BattlePetSlots returns the character's three battle pet slots. Look
+// the slotted pets up with PetList.Pet.
+func (c *Character) BattlePetSlots() ([]*PetSlot, error) {
+	err := c.ensure("petSlots", c.PetSlots != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.PetSlots == nil {
+		return make([]*PetSlot, 0), nil
+	}
+	return c.PetSlots, nil
+}
+
+// StabledPets returns a hunter's pets. Other classes have none.
+func (c *Character) StabledPets() ([]*HunterPet, error) {
+	err := c.ensure("hunterPets", c.HunterPets != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.HunterPets == nil {
+		return make([]*HunterPet, 0), nil
+	}
+	return c.HunterPets, nil
+}
+
+// CompletedQuests returns the ids of the quests the character has
+// completed. The list can be long, so it is returned as is rather than
+// copied; pass a slice of it to ApiClient.GetQuests for the quests
+// themselves.
+func (c *Character) CompletedQuests() ([]int, error) {
+	err := c.ensure("quests", c.Quests != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Quests == nil {
+		return make([]int, 0), nil
+	}
+	return c.Quests, nil
+}
+
+// HasCompletedQuest reports whether id is among the character's
+// completed quests. Unlike CompletedQuests it doesn't fetch anything.
+func (c *Character) HasCompletedQuest(id int) bool {
+	for _, quest := range c.Quests {
+		if quest == id {
+			return true
+		}
+	}
+	return false
+}
+
+// GuildMembership returns the character's guild with its emblem, or
+// nil if the character isn't in a guild. Use Expand for the full
+// Guild.
+func (c *Character) GuildMembership() (*SimpleGuild, error) {
+	err := c.ensure("guild", c.Guild != nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.Guild, nil
+}
+
+// GuildRank returns the character's rank in its guild, 0 being the
+// guild master. The "guild" field doesn't include the rank, so this
+// fetches the guild's member list.
+func (c *Character) GuildRank() (int, error) {
+	if c.ApiClient == nil {
+		return 0, errors.New("Character instance does not have an ApiClient reference. Please set ApiClient before calling GuildRank().")
+	}
+	simpleGuild, err := c.GuildMembership()
+	if err != nil {
+		return 0, err
+	}
+	if simpleGuild == nil {
+		return 0, errors.New(fmt.Sprintf("Character %s is not in a guild", c.Name))
+	}
+	guild, err := simpleGuild.Expand(c.ApiClient, "members")
+	if err != nil {
+		return 0, err
+	}
+	for _, member := range guild.Members {
+		if member.Character != nil && member.Character.Name == c.Name && Slugify(member.Character.Realm) == Slugify(c.Realm) {
+			return member.Rank, nil
+		}
+	}
+	return 0, errors.New(fmt.Sprintf("Character %s is not a member of %s", c.Name, guild.Name))
+}
+
+// Customization returns how the character looks. Equipped gear is in
+// EquippedItems.
+func (c *Character) Customization() (*CharacterAppearance, error) {
+	err := c.ensure("appearance", c.Appearance != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Appearance == nil {
+		return &CharacterAppearance{}, nil
+	}
+	return c.Appearance, nil
+}
+
+// CompletedAchievements returns the character's achievement and
+// criteria progress.
+func (c *Character) CompletedAchievements() (*AchievementList, error) {
+	err := c.ensure("achievements", c.Achievements != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Achievements == nil {
+		return &AchievementList{}, nil
+	}
+	return c.Achievements, nil
+}
+
+// RaidProgression returns the character's raid progress.
+func (c *Character) RaidProgression() ([]*Raid, error) {
+	err := c.ensure("progression", c.Progression != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Progression == nil {
+		return make([]*Raid, 0), nil
+	}
+	return c.Progression.Raids, nil
+}
+
+// Reputations returns the character's standing with each faction.
+func (c *Character) Reputations() ([]*Reputation, error) {
+	err := c.ensure("reputation", c.Reputation != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Reputation == nil {
+		return make([]*Reputation, 0), nil
+	}
+	return c.Reputation, nil
+}
+
+// LearnedProfessions returns the character's primary and secondary
+// professions with the recipes it knows.
+func (c *Character) LearnedProfessions() (*ProfessionList, error) {
+	err := c.ensure("professions", c.Professions != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Professions == nil {
+		return &ProfessionList{}, nil
+	}
+	return c.Professions, nil
+}
+
+// AvailableTitles returns the titles the character has earned.
+func (c *Character) AvailableTitles() ([]*Title, error) {
+	err := c.ensure("titles", c.Titles != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Titles == nil {
+		return make([]*Title, 0), nil
+	}
+	return c.Titles, nil
+}
+
+// SelectedTitle returns the title the character is displaying, or nil
+// if it isn't displaying one.
+func (c *Character) SelectedTitle() (*Title, error) {
+	titles, err := c.AvailableTitles()
+	if err != nil {
+		return nil, err
+	}
+	for _, title := range titles {
+		if title.Selected {
+			return title, nil
+		}
+	}
+	return nil, nil
+}
+
+// CombatStats returns the character's stats.
+func (c *Character) CombatStats() (*CharacterStats, error) {
+	err := c.ensure("stats", c.Stats != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Stats == nil {
+		return &CharacterStats{}, nil
+	}
+	return c.Stats, nil
+}
+
+// EquippedItems returns the character's gear and average item levels.
+// Each item's Id, BonusLists and TooltipParams describe the exact
+// version equipped.
+func (c *Character) EquippedItems() (*ItemList, error) {
+	err := c.ensure("items", c.Items != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Items == nil {
+		return &ItemList{}, nil
+	}
+	return c.Items, nil
+}
+
+// PvPBrackets returns the character's rated PvP brackets. Fetching
+// them also fills in TotalHonorableKills.
+func (c *Character) PvPBrackets() (*BracketList, error) {
+	err := c.ensure("pvp", c.PvP != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.PvP == nil || c.PvP.Brackets == nil {
+		return &BracketList{}, nil
+	}
+	return c.PvP.Brackets, nil
+}
+
+// AvatarURL returns the URL of the character's small portrait, or ""
+// if the character has no Thumbnail. The image is served from the
+// render host of the ApiClient's region, or the US one if the
+// character has no ApiClient.
+func (c *Character) AvatarURL() string {
+	return c.renderURL("avatar")
+}
+
+// InsetURL returns the URL of the character's cropped upper-body
+// render.
+func (c *Character) InsetURL() string {
+	return c.renderURL("inset")
+}
+
+// RenderURL returns the URL of the character's full-body render.
+func (c *Character) RenderURL() string {
+	return c.renderURL("main")
+}
+
+// renderURL swaps the "-avatar.jpg" suffix of the thumbnail path for
+// the requested kind of image.
+func (c *Character) renderURL(kind string) string {
+	if c.Thumbnail == "" {
+		return ""
+	}
+	region := RegionUS
+	if c.ApiClient != nil {
+		region = c.ApiClient.Region
+	}
+	path := strings.TrimSuffix(c.Thumbnail, "-avatar.jpg") + "-" + kind + ".jpg"
+	return fmt.Sprintf("https://%s/character/%s", renderHostFor(region), path)
+}
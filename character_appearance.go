@@ -8,4 +8,7 @@ type CharacterAppearance struct {
 	FeatureVariation int
 	ShowHelm         bool
 	ShowCloak        bool
+	// CustomDisplayOptions are race specific options such as tattoos
+	// or horns, in the order the character creation screen lists them.
+	CustomDisplayOptions []int
 }
@@ -2,12 +2,48 @@ package wow
 
 import (
 	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"time"
 )
 
 type CharacterSuite struct{}
 
 var _ = Suite(&CharacterSuite{})
 
+// characterFixture serves Capoferro of Runetotem for the accessor
+// tests.
+type characterFixture struct {
+	server *httptest.Server
+	calls  int
+	ch     *Character
+}
+
+// newCharacterFixture starts a server that checks that the given
+// fields are requested and answers with the character's name and realm
+// followed by body, the JSON of the fields without the enclosing
+// braces.
+func newCharacterFixture(c *C, fields string, body string) *characterFixture {
+	f := &characterFixture{}
+	if body != "" {
+		body = "," + body
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.calls++
+		c.Check(r.URL.Path, Equals, "/wow/character/runetotem/Capoferro")
+		c.Check(r.URL.Query().Get("fields"), Equals, fields)
+		w.Write([]byte(`{"name":"Capoferro","realm":"Runetotem"` + body + `}`))
+	}))
+	client, _ := NewApiClient("US", "")
+	client.Host = f.server.Listener.Addr().String()
+	f.ch = &Character{ApiClient: client, Name: "Capoferro", Realm: "Runetotem"}
+	return f
+}
+
+func (f *characterFixture) Close() {
+	f.server.Close()
+}
+
 func (s *CharacterSuite) Test_CharacterClass_noApiClient(c *C) {
 	ch := &Character{}
 	_, err := ch.Class()
@@ -27,3 +63,264 @@ func (s *CharacterSuite) Test_CharacterClass(c *C) {
 	class, _ := ch.Class()
 	c.Assert(class, Equals, "Death Knight")
 }
+
+func (s *CharacterSuite) Test_CollectedMounts(c *C) {
+	f := newCharacterFixture(c, "mounts", `"mounts":{"numCollected":1,"numNotCollected":2,"collected":[{"name":"Acherus Deathcharger","spellId":48778,"qualityId":4,"isGround":true}]}`)
+	defer f.Close()
+	ch := f.ch
+	ch.ClassId = 6
+	mounts, err := ch.CollectedMounts()
+	c.Assert(err, IsNil)
+	c.Assert(mounts.NumCollected, Equals, 1)
+	c.Assert(mounts.SpellIds(), DeepEquals, []int{48778})
+	c.Assert(mounts.Collected[0].QualityId, Equals, 4)
+	c.Assert(ch.ClassId, Equals, 6)
+}
+
+func (s *CharacterSuite) Test_CollectedMounts_omitted(c *C) {
+	f := newCharacterFixture(c, "mounts", "")
+	defer f.Close()
+	mounts, err := f.ch.CollectedMounts()
+	c.Assert(err, IsNil)
+	c.Assert(mounts.NumCollected, Equals, 0)
+	c.Assert(mounts.SpellIds(), HasLen, 0)
+	_, err = f.ch.CollectedMounts()
+	c.Assert(err, IsNil)
+	c.Assert(f.calls, Equals, 1)
+
+	ch, err := f.ch.ApiClient.GetCharacterWithFields("Runetotem", "Capoferro", []string{"mounts"})
+	c.Assert(err, IsNil)
+	_, err = ch.CollectedMounts()
+	c.Assert(err, IsNil)
+	c.Assert(f.calls, Equals, 2)
+}
+
+func (s *CharacterSuite) Test_PvPBrackets(c *C) {
+	f := newCharacterFixture(c, "pvp", `"totalHonorableKills":4711,"pvp":{"brackets":{"ARENA_BRACKET_RBG":{"slug":"rbg","rating":1650,"seasonPlayed":20,"seasonWon":12,"seasonLost":8},"ARENA_BRACKET_3v3":{"slug":"3v3","rating":2100,"weeklyPlayed":10,"weeklyWon":7,"weeklyLost":3},"ARENA_BRACKET_2v2":{"slug":"2v2","rating":1800}}}`)
+	defer f.Close()
+	ch := f.ch
+	brackets, err := ch.PvPBrackets()
+	c.Assert(err, IsNil)
+	c.Assert(ch.TotalHonorableKills, Equals, 4711)
+	c.Assert(brackets.ArenaBracket5v5, IsNil)
+	all := brackets.All()
+	c.Assert(len(all), Equals, 3)
+	c.Assert(all[0].Slug, Equals, "2v2")
+	c.Assert(all[0].Rating, Equals, 1800)
+	c.Assert(all[1].Slug, Equals, "3v3")
+	c.Assert(all[1].WeeklyWon, Equals, 7)
+	c.Assert(all[2].Slug, Equals, "rbg")
+	c.Assert(all[2].SeasonLost, Equals, 8)
+	c.Assert((&BracketList{}).All(), HasLen, 0)
+}
+
+func (s *CharacterSuite) Test_Pets(c *C) {
+	f := newCharacterFixture(c, "pets,petSlots", `"pets":{"numCollected":1,"numNotCollected":900,"collected":[{"name":"Thor","battlePetGuid":"0000000001","stats":{"speciesId":258,"breedId":5,"level":25}}]},"petSlots":[{"slot":0,"battlePetGuid":"0000000001","abilities":[640,641,642]},{"slot":1,"isEmpty":true},{"slot":2,"isLocked":true}]`)
+	defer f.Close()
+	ch := f.ch
+	c.Assert(ch.load("pets", "petSlots"), IsNil)
+	pets, err := ch.CollectedPets()
+	c.Assert(err, IsNil)
+	c.Assert(pets.NumNotCollected, Equals, 900)
+	slots, err := ch.BattlePetSlots()
+	c.Assert(err, IsNil)
+	c.Assert(len(slots), Equals, 3)
+	c.Assert(slots[2].IsLocked, Equals, true)
+	pet := pets.Pet(slots[0].BattlePetGuid)
+	c.Assert(pet.Name, Equals, "Thor")
+	c.Assert(pet.SpeciesId(), Equals, 258)
+	c.Assert(pets.Pet(slots[1].BattlePetGuid), IsNil)
+}
+
+func (s *CharacterSuite) Test_StabledPets(c *C) {
+	f := newCharacterFixture(c, "hunterPets", `"hunterPets":[{"name":"Hati","creature":38453,"slot":0,"selected":true,"familyId":1,"familyName":"Wolf","spec":{"name":"Ferocity","role":"DPS","icon":"ability_druid_swipe"},"calcSpec":"b"}]`)
+	defer f.Close()
+	ch := f.ch
+	pets, err := ch.StabledPets()
+	c.Assert(err, IsNil)
+	c.Assert(len(pets), Equals, 1)
+	c.Assert(pets[0].Creature, Equals, 38453)
+	c.Assert(pets[0].FamilyName, Equals, "Wolf")
+	c.Assert(pets[0].Spec.Name, Equals, "Ferocity")
+}
+
+func (s *CharacterSuite) Test_CompletedQuests(c *C) {
+	f := newCharacterFixture(c, "quests", `"quests":[13,5,7]`)
+	defer f.Close()
+	ch := f.ch
+	c.Assert(ch.HasCompletedQuest(5), Equals, false)
+	quests, err := ch.CompletedQuests()
+	c.Assert(err, IsNil)
+	c.Assert(quests, DeepEquals, []int{13, 5, 7})
+	c.Assert(ch.HasCompletedQuest(5), Equals, true)
+	c.Assert(ch.HasCompletedQuest(6), Equals, false)
+}
+
+func (s *CharacterSuite) Test_GuildMembership(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/guild/runetotem/Rage Tanks" {
+			c.Check(r.URL.Query().Get("fields"), Equals, "members")
+			w.Write([]byte(`{"name":"Rage Tanks","realm":"Runetotem","members":[{"character":{"name":"Someone","realm":"Runetotem"},"rank":0},{"character":{"name":"Capoferro","realm":"Runetotem"},"rank":3}]}`))
+			return
+		}
+		c.Check(r.URL.Query().Get("fields"), Equals, "guild")
+		w.Write([]byte(`{"name":"Capoferro","realm":"Runetotem","guild":{"name":"Rage Tanks","realm":"Runetotem","members":2,"emblem":{"icon":126,"iconColor":"ffb1b8b1"}}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	ch := &Character{ApiClient: client, Name: "Capoferro", Realm: "Runetotem"}
+	guild, err := ch.GuildMembership()
+	c.Assert(err, IsNil)
+	c.Assert(guild.Name, Equals, "Rage Tanks")
+	c.Assert(guild.Emblem.Icon, Equals, 126)
+	rank, err := ch.GuildRank()
+	c.Assert(err, IsNil)
+	c.Assert(rank, Equals, 3)
+}
+
+func (s *CharacterSuite) Test_Customization(c *C) {
+	f := newCharacterFixture(c, "appearance", `"appearance":{"faceVariation":3,"skinColor":1,"hairVariation":6,"hairColor":2,"featureVariation":0,"showHelm":false,"showCloak":true,"customDisplayOptions":[0,2,1]}`)
+	defer f.Close()
+	ch := f.ch
+	appearance, err := ch.Customization()
+	c.Assert(err, IsNil)
+	c.Assert(appearance.HairVariation, Equals, 6)
+	c.Assert(appearance.ShowHelm, Equals, false)
+	c.Assert(appearance.ShowCloak, Equals, true)
+	c.Assert(appearance.CustomDisplayOptions, DeepEquals, []int{0, 2, 1})
+}
+
+func (s *CharacterSuite) Test_CompletedAchievements(c *C) {
+	f := newCharacterFixture(c, "achievements", `"achievements":{"achievementsCompleted":[6,7],"achievementsCompletedTimestamp":[1225670520000,1225670521500],"criteria":[34],"criteriaQuantity":[3]}`)
+	defer f.Close()
+	ch := f.ch
+	achievements, err := ch.CompletedAchievements()
+	c.Assert(err, IsNil)
+	c.Assert(achievements.Criteria, DeepEquals, []int{34})
+	c.Assert(achievements.CriteriaQuantity, DeepEquals, []int{3})
+	completed := achievements.Completed()
+	c.Assert(len(completed), Equals, 2)
+	c.Assert(completed[7].Equal(time.Unix(1225670521, int64(500*time.Millisecond))), Equals, true)
+}
+
+func (s *CharacterSuite) Test_LastModifiedTime(c *C) {
+	ch := &Character{LastModified: 1405387200123}
+	c.Assert(ch.LastModifiedTime().Equal(time.Unix(1405387200, int64(123*time.Millisecond))), Equals, true)
+	c.Assert((&Character{}).LastModifiedTime().IsZero(), Equals, true)
+}
+
+func (s *CharacterSuite) Test_RaidProgression(c *C) {
+	f := newCharacterFixture(c, "progression", `"progression":{"raids":[{"name":"Hellfire Citadel","normal":2,"heroic":1,"mythic":0,"bosses":[
+			{"name":"Hellfire Assault","normalKills":3,"normalTimestamp":1440000000000,"heroicKills":1,"heroicTimestamp":1440600000500,"mythicKills":0,"mythicTimestamp":0},
+			{"name":"Iron Reaver","normalKills":2,"normalTimestamp":1440000000000,"heroicKills":0,"heroicTimestamp":0}]}]}`)
+	defer f.Close()
+	ch := f.ch
+	raids, err := ch.RaidProgression()
+	c.Assert(err, IsNil)
+	c.Assert(raids, HasLen, 1)
+	c.Assert(raids[0].Heroic, Equals, 1)
+	c.Assert(raids[0].BossesKilled(DifficultyNormal), Equals, 2)
+	c.Assert(raids[0].BossesKilled(DifficultyHeroic), Equals, 1)
+	c.Assert(raids[0].BossesKilled(DifficultyMythic), Equals, 0)
+	kills, last := raids[0].Bosses[0].Kills(DifficultyHeroic)
+	c.Assert(kills, Equals, 1)
+	c.Assert(last.Equal(time.Unix(1440600000, int64(500*time.Millisecond))), Equals, true)
+	_, last = raids[0].Bosses[1].Kills(DifficultyHeroic)
+	c.Assert(last.IsZero(), Equals, true)
+}
+
+func (s *CharacterSuite) Test_Reputations(c *C) {
+	f := newCharacterFixture(c, "reputation", `"reputation":[{"id":1119,"name":"The Sons of Hodir","standing":7,"value":999,"max":999},{"id":1037,"name":"Alliance Vanguard","standing":3,"value":120,"max":3000}]`)
+	defer f.Close()
+	ch := f.ch
+	reputations, err := ch.Reputations()
+	c.Assert(err, IsNil)
+	c.Assert(reputations, HasLen, 2)
+	c.Assert(reputations[0].Standing, Equals, StandingExalted)
+	c.Assert(reputations[1].Standing.String(), Equals, "Neutral")
+	c.Assert(reputations[1].Max, Equals, 3000)
+	c.Assert(Standing(9).String(), Equals, "Unknown")
+}
+
+func (s *CharacterSuite) Test_LearnedProfessions(c *C) {
+	f := newCharacterFixture(c, "professions", `"professions":{"primary":[{"id":333,"name":"Enchanting","rank":700,"max":700,"recipes":[7418,33994]}],"secondary":[{"id":185,"name":"Cooking","rank":1,"max":75,"recipes":[]}]}`)
+	defer f.Close()
+	ch := f.ch
+	professions, err := ch.LearnedProfessions()
+	c.Assert(err, IsNil)
+	c.Assert(professions.Primary, HasLen, 1)
+	c.Assert(professions.Primary[0].Name, Equals, "Enchanting")
+	c.Assert(professions.Primary[0].KnowsRecipe(33994), Equals, true)
+	c.Assert(professions.Secondary[0].KnowsRecipe(33994), Equals, false)
+	c.Assert(professions.Secondary[0].Max, Equals, 75)
+}
+
+func (s *CharacterSuite) Test_Titles(c *C) {
+	f := newCharacterFixture(c, "titles", `"titles":[{"id":47,"name":"%s the Kingslayer","selected":true},{"id":72,"name":"Private %s"}]`)
+	defer f.Close()
+	ch := f.ch
+	titles, err := ch.AvailableTitles()
+	c.Assert(err, IsNil)
+	c.Assert(titles, HasLen, 2)
+	c.Assert(titles[1].Format(ch.Name), Equals, "Private Capoferro")
+	selected, err := ch.SelectedTitle()
+	c.Assert(err, IsNil)
+	c.Assert(selected.Format(ch.Name), Equals, "Capoferro the Kingslayer")
+	c.Assert(f.calls, Equals, 1)
+}
+
+func (s *CharacterSuite) Test_CombatStats(c *C) {
+	f := newCharacterFixture(c, "stats", `"stats":{"health":2500000,"powerType":"runic-power","str":9000,"agi":1200,"int":900,"crit":21.5,"haste":12.25,"mastery":40.5,"versatility":1100,"versatilityDamageDoneBonus":4.5}`)
+	defer f.Close()
+	ch := f.ch
+	stats, err := ch.CombatStats()
+	c.Assert(err, IsNil)
+	c.Assert(stats.Health, Equals, 2500000)
+	c.Assert(stats.Crit, Equals, float32(21.5))
+	c.Assert(stats.Versatility, Equals, 1100)
+	name, value := stats.PrimaryStat()
+	c.Assert(name, Equals, "str")
+	c.Assert(value, Equals, 9000)
+}
+
+func (s *CharacterSuite) Test_ActivityFeed(c *C) {
+	f := newCharacterFixture(c, "feed", `"feed":[{"type":"LOOT","timestamp":1400000000500,"itemId":104426,"context":"raid-normal","bonusLists":[566]},{"type":"BOSSKILL","timestamp":1399999999000,"achievement":{"id":8619,"title":"Garrosh Hellscream kills"},"criteria":{"id":23384},"quantity":3,"name":"Garrosh Hellscream"},{"type":"ACHIEVEMENT","timestamp":1399999998000,"achievement":{"id":8482},"featOfStrength":false}]`)
+	defer f.Close()
+	ch := f.ch
+	feed, err := ch.ActivityFeed()
+	c.Assert(err, IsNil)
+	c.Assert(len(feed), Equals, 3)
+	c.Assert(feed[0].BonusLists, DeepEquals, []int{566})
+	c.Assert(feed[0].Time().UnixNano(), Equals, int64(1400000000500*time.Millisecond))
+	kills := ch.FeedOfType(FeedBossKill)
+	c.Assert(len(kills), Equals, 1)
+	c.Assert(kills[0].Name, Equals, "Garrosh Hellscream")
+	c.Assert(kills[0].Quantity, Equals, 3)
+	c.Assert(kills[0].Criteria.Id, Equals, 23384)
+}
+
+func (s *CharacterSuite) Test_EquippedItems(c *C) {
+	f := newCharacterFixture(c, "items", `"items":{"averageItemLevel":385,"averageItemLevelEquipped":383,"head":{"id":134423,"name":"Biornskin Hood","bonusLists":[1727,1492],"tooltipParams":{"enchant":5437,"gem0":130219}},"mainHand":{"id":128402,"name":"Maw of the Damned"}}`)
+	defer f.Close()
+	ch := f.ch
+	items, err := ch.EquippedItems()
+	c.Assert(err, IsNil)
+	c.Assert(items.AverageItemLevelEquipped, Equals, 383)
+	c.Assert(items.Head.BonusLists, DeepEquals, []int{1727, 1492})
+	c.Assert(items.Head.TooltipParams.Enchant, Equals, 5437)
+	slots := items.Slots()
+	c.Assert(len(slots), Equals, 2)
+	c.Assert(slots["mainHand"].Id, Equals, 128402)
+	c.Assert(items.Slot("neck"), IsNil)
+}
+
+func (s *CharacterSuite) Test_RenderURLs(c *C) {
+	client, _ := NewApiClient("EU", "")
+	ch := &Character{ApiClient: client, Thumbnail: "argent-dawn/66/115044674-avatar.jpg"}
+	c.Assert(ch.AvatarURL(), Equals, "https://render-eu.worldofwarcraft.com/character/argent-dawn/66/115044674-avatar.jpg")
+	c.Assert(ch.InsetURL(), Equals, "https://render-eu.worldofwarcraft.com/character/argent-dawn/66/115044674-inset.jpg")
+	c.Assert(ch.RenderURL(), Equals, "https://render-eu.worldofwarcraft.com/character/argent-dawn/66/115044674-main.jpg")
+	c.Assert((&Character{}).AvatarURL(), Equals, "")
+}
@@ -1,8 +1,39 @@
 package wow
 
 type Class struct {
-	Id        int
-	Mask      int
+	Id   int
+	Mask int
+	// PowerType is the class's primary resource, e.g. "rage",
+	// "energy" or "mana".
 	PowerType string
 	Name      string
 }
+
+// classColors are the standard in-game class colors, keyed by class
+// id.
+var classColors = map[int]string{
+	1:  "#C79C6E", // Warrior
+	2:  "#F58CBA", // Paladin
+	3:  "#ABD473", // Hunter
+	4:  "#FFF569", // Rogue
+	5:  "#FFFFFF", // Priest
+	6:  "#C41F3B", // Death Knight
+	7:  "#0070DE", // Shaman
+	8:  "#69CCF0", // Mage
+	9:  "#9482C9", // Warlock
+	10: "#00FF96", // Monk
+	11: "#FF7D0A", // Druid
+	12: "#A330C9", // Demon Hunter
+}
+
+// ClassColor returns the hex color, such as "#C41F3B", used for the
+// class with the given id, or "" for an unknown class. It works with
+// Character.ClassId as well as Class.Id.
+func ClassColor(classId int) string {
+	return classColors[classId]
+}
+
+// Color returns the class's hex color; see ClassColor.
+func (c *Class) Color() string {
+	return ClassColor(c.Id)
+}
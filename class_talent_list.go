@@ -12,4 +12,37 @@ type ClassTalentList struct {
 	Warlock     *TalentList `json:"9"`
 	Monk        *TalentList `json:"10"`
 	Druid       *TalentList `json:"11"`
+	DemonHunter *TalentList `json:"12"`
+}
+
+// ForClass returns the talents of the class with the given id, as in
+// Character.ClassId, or nil if there are none.
+func (l *ClassTalentList) ForClass(classId int) *TalentList {
+	switch classId {
+	case 1:
+		return l.Warrior
+	case 2:
+		return l.Paladin
+	case 3:
+		return l.Hunter
+	case 4:
+		return l.Rogue
+	case 5:
+		return l.Priest
+	case 6:
+		return l.Deathknight
+	case 7:
+		return l.Shaman
+	case 8:
+		return l.Mage
+	case 9:
+		return l.Warlock
+	case 10:
+		return l.Monk
+	case 11:
+		return l.Druid
+	case 12:
+		return l.DemonHunter
+	}
+	return nil
 }
@@ -0,0 +1,15 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type ClassSuite struct{}
+
+var _ = Suite(&ClassSuite{})
+
+func (s *ClassSuite) Test_Color(c *C) {
+	c.Assert((&Class{Id: 6, Name: "Death Knight"}).Color(), Equals, "#C41F3B")
+	c.Assert(ClassColor(12), Equals, "#A330C9")
+	c.Assert(ClassColor(99), Equals, "")
+}
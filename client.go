@@ -0,0 +1,111 @@
+package wow
+
+// Client is the set of API calls implemented by *ApiClient. Accept it
+// (or one of the smaller interfaces it's made of) instead of
+// *ApiClient to be able to substitute a fake in tests.
+type Client interface {
+	AchievementClient
+	AuctionClient
+	BattlePetClient
+	CharacterClient
+	GuildClient
+	ItemClient
+	LeaderboardClient
+	RealmClient
+	DataClient
+	GetRaw(path string, params map[string]string) ([]byte, error)
+	GetResource(resourcePath string, out interface{}) error
+	DownloadIcon(icon string, size string) ([]byte, string, error)
+	Close() error
+}
+
+var _ Client = (*ApiClient)(nil)
+
+type AchievementClient interface {
+	GetAchievement(id int) (*Achievement, error)
+	GetAchievements() ([]*Achievement, error)
+	AchievementById(id int) (*Achievement, error)
+	RefreshAchievements() error
+}
+
+type AuctionClient interface {
+	GetTokenPrice() (*TokenPrice, error)
+	GetAuctionData(realm string) (*AuctionData, error)
+	GetAuctionDump(realm string) (*AuctionDump, error)
+	GetAuctionDumpSince(realm string, since int64) (*AuctionDump, error)
+	HasNewAuctionData(realm string, since int64) (bool, error)
+	StreamAuctions(realm string, fn func(*Auction) error) error
+}
+
+type BattlePetClient interface {
+	GetBattlePet(id int, level int, breedId int, qualityId int) (*BattlePet, error)
+	GetBattlePetAbility(id int) (*BattlePetAbility, error)
+	GetBattlePetAbilities(ids []int) (map[int]*BattlePetAbility, error)
+	GetBattlePetSpecies(id int) (*BattlePetSpecies, error)
+	GetPetTypes() ([]*PetType, error)
+}
+
+type CharacterClient interface {
+	CharacterExists(realm string, characterName string) (bool, error)
+	GetCharacter(realm string, characterName string) (*Character, error)
+	GetCharacterWithFields(realm string, characterName string, fields []string) (*Character, error)
+	GetClasses() ([]*Class, error)
+	GetRaces() ([]*Race, error)
+	GetTalents() (*ClassTalentList, error)
+	TalentsForClass(classId int) (*TalentList, error)
+}
+
+type GuildClient interface {
+	GetGuild(realm string, guildName string) (*Guild, error)
+	GetGuildWithFields(realm string, guildName string, fields []string) (*Guild, error)
+	GetGuildAchievements() ([]*Achievement, error)
+	GetGuildPerks() ([]*GuildPerk, error)
+	GetGuildRewards() ([]*GuildReward, error)
+}
+
+type ItemClient interface {
+	GetItem(id int) (*Item, error)
+	GetItemLocale(id int, locale string) (*Item, error)
+	ItemName(id int, locale string) (string, error)
+	SearchItems(name string) ([]*Item, error)
+	SearchItemsLocale(name string, locale string) ([]*Item, error)
+	GetItemWithBonusLists(id int, bonusLists []int) (*Item, error)
+	GetItems(ids []int) (map[int]*Item, error)
+	GetItemClasses() ([]*ItemClass, error)
+	GetItemSet(id int) (*ItemSet, error)
+	GetItemSets(ids []int) (map[int]*ItemSet, error)
+}
+
+type LeaderboardClient interface {
+	GetChallenges(realm string) ([]*Challenge, error)
+	GetMythicKeystoneLeaderboard(connectedRealmId int, dungeonId int, period int) (*MythicKeystoneLeaderboard, error)
+	GetPvPLeaderboard(bracket Bracket) ([]*PvPLeaderboardRow, error)
+	GetPvPLeaderboardRange(bracket Bracket, offset int, limit int) ([]*PvPLeaderboardRow, error)
+}
+
+type RealmClient interface {
+	GetBattlegroups() ([]*Battlegroup, error)
+	GetConnectedRealm(id int) (*ConnectedRealm, error)
+	GetConnectedRealmAuctions(realm string) (*ConnectedRealmAuctions, error)
+	GetConnectedRealmId(realm string) (int, error)
+	GetConnectedRealmIds() ([]int, error)
+	GetConnectedRealms() ([]*ConnectedRealm, error)
+	GetRealmStatus() ([]*RealmStatus, error)
+	GetRealmStatusFor(realms ...string) ([]*RealmStatus, error)
+}
+
+// DataClient covers the remaining static game data.
+type DataClient interface {
+	GetBoss(id int) (*Boss, error)
+	GetBosses() ([]*Boss, error)
+	GetMounts() ([]*Mount, error)
+	GetQuest(id int) (*Quest, error)
+	GetQuests(ids []int) (map[int]*Quest, error)
+	GetRecipe(id int) (*Recipe, error)
+	GetRecipes(ids []int) (map[int]*Recipe, error)
+	GetSpell(id int) (*Spell, error)
+	GetSpellLocale(id int, locale string) (*Spell, error)
+	GetSpells(ids []int) (map[int]*Spell, error)
+	GetZone(id int) (*Zone, error)
+	GetZones() ([]*Zone, error)
+}
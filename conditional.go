@@ -0,0 +1,61 @@
+package wow
+
+import (
+	"net/http"
+	"sync"
+)
+
+// conditionalStore remembers the validators and body of each response
+// so the request can be repeated with If-None-Match and
+// If-Modified-Since, and the stored body reused on a 304.
+type conditionalStore struct {
+	mu      sync.Mutex
+	entries map[string]*conditionalEntry
+}
+
+type conditionalEntry struct {
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+func newConditionalStore() *conditionalStore {
+	return &conditionalStore{entries: make(map[string]*conditionalEntry)}
+}
+
+// clear forgets every stored response.
+func (c *conditionalStore) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*conditionalEntry)
+}
+
+func (c *conditionalStore) get(key string) *conditionalEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[key]
+}
+
+// set stores the response's validators, if it has any.
+func (c *conditionalStore) set(key string, header http.Header, body []byte) {
+	entry := &conditionalEntry{
+		etag:         header.Get("ETag"),
+		lastModified: header.Get("Last-Modified"),
+		body:         body,
+	}
+	if entry.etag == "" && entry.lastModified == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = entry
+}
+
+func (e *conditionalEntry) addHeaders(request *http.Request) {
+	if e.etag != "" {
+		request.Header.Set("If-None-Match", e.etag)
+	}
+	if e.lastModified != "" {
+		request.Header.Set("If-Modified-Since", e.lastModified)
+	}
+}
@@ -0,0 +1,26 @@
+package wow
+
+// ConnectedRealm is a group of realms that share an auction house and
+// other services.
+type ConnectedRealm struct {
+	Id         int
+	HasQueue   bool `json:"has_queue"`
+	Status     *TypeName
+	Population *TypeName
+	Realms     []*ConnectedRealmMember
+}
+
+// IsOnline reports whether the connected realm's realms are up.
+func (c *ConnectedRealm) IsOnline() bool {
+	return c.Status != nil && c.Status.Type == "UP"
+}
+
+// Slugs returns the slugs of the member realms, as used by
+// GetAuctionData and GetRealmStatus.
+func (c *ConnectedRealm) Slugs() []string {
+	slugs := make([]string, 0, len(c.Realms))
+	for _, realm := range c.Realms {
+		slugs = append(slugs, realm.Slug)
+	}
+	return slugs
+}
@@ -0,0 +1,39 @@
+package wow
+
+// connectedRealmAuctionList is the game data API's auction format,
+// which connectedRealmAuction converts to the Auction type used for
+// the older auction files.
+type connectedRealmAuctionList struct {
+	Auctions []*connectedRealmAuction
+}
+
+type connectedRealmAuction struct {
+	Id   int
+	Item struct {
+		Id      int
+		Context int
+	}
+	Bid       int
+	Buyout    int
+	UnitPrice int `json:"unit_price"`
+	Quantity  int
+	TimeLeft  string `json:"time_left"`
+}
+
+// auction converts the entry. Commodities only have a UnitPrice, which
+// becomes the Buyout.
+func (c *connectedRealmAuction) auction() *Auction {
+	buyout := c.Buyout
+	if buyout == 0 {
+		buyout = c.UnitPrice
+	}
+	return &Auction{
+		Auc:      c.Id,
+		Item:     c.Item.Id,
+		Bid:      c.Bid,
+		Buyout:   buyout,
+		Quantity: c.Quantity,
+		TimeLeft: c.TimeLeft,
+		Context:  c.Item.Context,
+	}
+}
@@ -0,0 +1,8 @@
+package wow
+
+// ConnectedRealmAuctions is the auction house of a connected realm,
+// as returned by GetConnectedRealmAuctions.
+type ConnectedRealmAuctions struct {
+	ConnectedRealm *ConnectedRealm
+	Auctions       []*Auction
+}
@@ -0,0 +1,42 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+type connectedRealmIndex struct {
+	ConnectedRealms []*struct {
+		Href string
+	} `json:"connected_realms"`
+}
+
+// Ids parses the connected realm ids out of the index's links.
+func (c *connectedRealmIndex) Ids() ([]int, error) {
+	ids := make([]int, 0, len(c.ConnectedRealms))
+	for _, link := range c.ConnectedRealms {
+		id, err := connectedRealmLinkId(link.Href)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// connectedRealmLinkId parses the id out of a connected realm link,
+// which looks like https://us.api.blizzard.com/data/wow/connected-realm/11?namespace=dynamic-us
+func connectedRealmLinkId(href string) (int, error) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.Atoi(path.Base(u.Path))
+	if err != nil {
+		return 0, errors.New(fmt.Sprintf("Unexpected connected realm link: %s", href))
+	}
+	return id, nil
+}
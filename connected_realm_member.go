@@ -0,0 +1,12 @@
+package wow
+
+type ConnectedRealmMember struct {
+	Id           int
+	Name         string
+	Slug         string
+	Category     string
+	Locale       string
+	Timezone     string
+	Type         *TypeName
+	IsTournament bool `json:"is_tournament"`
+}
@@ -0,0 +1,27 @@
+package wow
+
+// CriteriaProgress is a character's progress towards one criterion of
+// an achievement.
+type CriteriaProgress struct {
+	Criteria *AchievementCriteria
+	Quantity int
+}
+
+// Percent returns how complete the criterion is, from 0 to 100.
+// Criteria without a Max are either done or not.
+func (p *CriteriaProgress) Percent() float64 {
+	if p.Criteria.Max <= 0 {
+		if p.Quantity > 0 {
+			return 100
+		}
+		return 0
+	}
+	if p.Quantity >= p.Criteria.Max {
+		return 100
+	}
+	return float64(p.Quantity) * 100 / float64(p.Criteria.Max)
+}
+
+func (p *CriteriaProgress) IsComplete() bool {
+	return p.Percent() >= 100
+}
@@ -0,0 +1,9 @@
+package wow
+
+// DataReference is how the game data API refers to another object,
+// such as a map, realm or specialization, inside a response.
+type DataReference struct {
+	Id   int
+	Name string
+	Slug string
+}
@@ -0,0 +1,13 @@
+package wow
+
+// Difficulty is a raid difficulty as reported in character
+// progression.
+type Difficulty string
+
+const (
+	DifficultyLFR    Difficulty = "lfr"
+	DifficultyFlex   Difficulty = "flex"
+	DifficultyNormal Difficulty = "normal"
+	DifficultyHeroic Difficulty = "heroic"
+	DifficultyMythic Difficulty = "mythic"
+)
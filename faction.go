@@ -0,0 +1,37 @@
+package wow
+
+import "strings"
+
+// Faction is Blizzard's faction id, as used for the FactionId of
+// leaderboard rows and the Side of guilds.
+type Faction int
+
+const (
+	FactionAlliance Faction = 0
+	FactionHorde    Faction = 1
+	FactionNeutral  Faction = 2
+)
+
+var factionNames = map[Faction]string{
+	FactionAlliance: "alliance",
+	FactionHorde:    "horde",
+	FactionNeutral:  "neutral",
+}
+
+func (f Faction) String() string {
+	if name, ok := factionNames[f]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// parseFaction converts a faction name, such as a Race's Side, to a
+// Faction.
+func parseFaction(name string) (Faction, bool) {
+	for faction, factionName := range factionNames {
+		if strings.EqualFold(name, factionName) {
+			return faction, true
+		}
+	}
+	return 0, false
+}
@@ -1,5 +1,19 @@
 package wow
 
+import "time"
+
+// Character feed entry types. Achievement entries have an Achievement
+// and FeatOfStrength; criteria entries the Achievement and the
+// Criteria met; loot entries an ItemId with its Context and
+// BonusLists; boss kill entries the boss's Name, the kill count in
+// Quantity, and the statistic it counts as Achievement and Criteria.
+const (
+	FeedAchievement = "ACHIEVEMENT"
+	FeedCriteria    = "CRITERIA"
+	FeedLoot        = "LOOT"
+	FeedBossKill    = "BOSSKILL"
+)
+
 type FeedEntry struct {
 	Type           string
 	Timestamp      uint64
@@ -9,4 +23,11 @@ type FeedEntry struct {
 	Quantity       int
 	Name           string
 	ItemId         int
+	Context        string
+	BonusLists     []int
+}
+
+// Time returns when the entry happened.
+func (f *FeedEntry) Time() time.Time {
+	return msToTime(int64(f.Timestamp))
 }
@@ -1,5 +1,10 @@
 package wow
 
+import (
+	"errors"
+	"time"
+)
+
 type Guild struct {
 	Name              string
 	Realm             string
@@ -13,14 +18,91 @@ type Guild struct {
 	Achievements      *AchievementList
 	News              []*GuildNewsItem
 	Challenge         []*Challenge
+	ApiClient         *ApiClient
+}
+
+// Faction returns the guild's Side as a Faction.
+func (g *Guild) Faction() Faction {
+	return Faction(g.Side)
+}
+
+// LastModifiedTime returns LastModified, which the API gives in
+// milliseconds since the epoch, as a time.Time. It is the zero time if
+// LastModified is not set.
+func (g *Guild) LastModifiedTime() time.Time {
+	if g.LastModified == 0 {
+		return time.Time{}
+	}
+	return msToTime(int64(g.LastModified))
+}
+
+// Challenges returns the guild's best challenge mode runs for each
+// dungeon, fetching the "challenge" field if the guild was retrieved
+// without it.
+func (g *Guild) Challenges() ([]*Challenge, error) {
+	if g.Challenge == nil {
+		if g.ApiClient == nil {
+			return nil, errors.New("Guild instance does not have an ApiClient reference. Please set ApiClient before loading challenges.")
+		}
+		err := g.ApiClient.loadGuild(g, g.Realm, g.Name, []string{"challenge"})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if g.Challenge == nil {
+		return make([]*Challenge, 0), nil
+	}
+	return g.Challenge, nil
+}
+
+// MemberCharacters returns the guild's members with their ranks,
+// fetching the "members" field if the guild was retrieved without it.
+// The characters have the guild's ApiClient, so methods such as
+// Class and CollectedMounts can be called on them.
+func (g *Guild) MemberCharacters() ([]*GuildCharacter, error) {
+	if g.Members == nil {
+		if g.ApiClient == nil {
+			return nil, errors.New("Guild instance does not have an ApiClient reference. Please set ApiClient before loading members.")
+		}
+		err := g.ApiClient.loadGuild(g, g.Realm, g.Name, []string{"members"})
+		if err != nil {
+			return nil, err
+		}
+	}
+	members := make([]*GuildCharacter, 0, len(g.Members))
+	for _, member := range g.Members {
+		if member.Character == nil {
+			continue
+		}
+		members = append(members, &GuildCharacter{
+			Rank:      member.Rank,
+			Character: member.Character.toCharacter(g.ApiClient),
+		})
+	}
+	return members, nil
+}
+
+func (g *Guild) ItemNews() []*GuildNewsItem {
+	return g.NewsOfType(NewsItemLoot)
+}
+
+// AchievementNews returns the news of achievements earned by the guild
+// or by its members.
+func (g *Guild) AchievementNews() []*GuildNewsItem {
+	return g.NewsOfType(NewsGuildAchievement, NewsPlayerAchievement)
 }
 
-func (g *Guild) ItemNews() []*GuildNewsItem{
-	itemNews := make([]*GuildNewsItem, 0)
+// NewsOfType returns the news items of any of the given types, such as
+// NewsItemCraft, in the order the API returned them.
+func (g *Guild) NewsOfType(types ...string) []*GuildNewsItem {
+	news := make([]*GuildNewsItem, 0)
 	for _, n := range g.News {
-		if n.Type == "itemLoot" {
-			itemNews = append(itemNews, n)
+		for _, t := range types {
+			if n.Type == t {
+				news = append(news, n)
+				break
+			}
 		}
 	}
-	return itemNews
+	return news
 }
@@ -0,0 +1,8 @@
+package wow
+
+// GuildCharacter is a guild member as a full Character; see
+// Guild.MemberCharacters.
+type GuildCharacter struct {
+	Rank      int
+	Character *Character
+}
@@ -6,6 +6,18 @@ import (
 	"fmt"
 )
 
+// Guild news types. Item news have an ItemId, achievement news an
+// Achievement; Character is empty for guild-wide news.
+const (
+	NewsItemLoot          = "itemLoot"
+	NewsItemPurchase      = "itemPurchase"
+	NewsItemCraft         = "itemCraft"
+	NewsGuildCreated      = "guildCreated"
+	NewsGuildLevel        = "guildLevel"
+	NewsGuildAchievement  = "guildAchievement"
+	NewsPlayerAchievement = "playerAchievement"
+)
+
 type GuildNewsItem struct {
 	Type        string
 	Character   string
@@ -16,8 +28,19 @@ type GuildNewsItem struct {
 }
 
 
-func (g *GuildNewsItem) Time() time.Time{
-	return time.Unix(int64(g.Timestamp)/1000, int64(g.Timestamp)%1000)
+// IsItem reports whether the news is about an item looted, bought or
+// crafted by a member.
+func (g *GuildNewsItem) IsItem() bool {
+	return g.Type == NewsItemLoot || g.Type == NewsItemPurchase || g.Type == NewsItemCraft
+}
+
+// IsAchievement reports whether the news is about an achievement.
+func (g *GuildNewsItem) IsAchievement() bool {
+	return g.Type == NewsGuildAchievement || g.Type == NewsPlayerAchievement
+}
+
+func (g *GuildNewsItem) Time() time.Time {
+	return msToTime(int64(g.Timestamp))
 }
 
 func (g *GuildNewsItem) Ago() time.Duration {
@@ -49,7 +72,7 @@ func (g *GuildNewsItem) Item() (*Item, error) {
 			g.item, err = client.GetItem(g.ItemId)
 			return g.item, err
 		} else {
-			return nil, errors.New("No API client created. Create one via NewApiClient")
+			return nil, errors.New("No current API client. Register one via SetCurrentApiClient")
 		}
 	}
 	return nil, errors.New("No ItemId set")
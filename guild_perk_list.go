@@ -3,3 +3,16 @@ package wow
 type guildPerkList struct {
 	Perks []*GuildPerk
 }
+
+// PerksAtLevel returns the perks that unlock at exactly the given guild
+// level, not those from earlier levels. Pass the list from
+// GetGuildPerks.
+func PerksAtLevel(perks []*GuildPerk, level int) []*GuildPerk {
+	unlocked := make([]*GuildPerk, 0)
+	for _, perk := range perks {
+		if perk.GuildLevel == level {
+			unlocked = append(unlocked, perk)
+		}
+	}
+	return unlocked
+}
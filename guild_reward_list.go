@@ -3,3 +3,16 @@ package wow
 type guildRewardList struct {
 	Rewards []*GuildReward
 }
+
+// RewardsAtLevel returns the rewards that unlock at exactly the given
+// guild level, not those from earlier levels. Pass the list from
+// GetGuildRewards.
+func RewardsAtLevel(rewards []*GuildReward, level int) []*GuildReward {
+	unlocked := make([]*GuildReward, 0)
+	for _, reward := range rewards {
+		if reward.MinGuildLevel == level {
+			unlocked = append(unlocked, reward)
+		}
+	}
+	return unlocked
+}
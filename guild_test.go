@@ -0,0 +1,105 @@
+package wow
+
+import (
+	"encoding/json"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+type GuildSuite struct{}
+
+var _ = Suite(&GuildSuite{})
+
+func (s *GuildSuite) Test_News(c *C) {
+	guild := &Guild{}
+	err := json.Unmarshal([]byte(`{"news":[
+		{"type":"itemLoot","character":"Capoferro","timestamp":1400000000000,"itemId":104426},
+		{"type":"guildAchievement","timestamp":1400000000000,"achievement":{"id":5362,"title":"Guild Level 25"}},
+		{"type":"playerAchievement","character":"Capoferro","timestamp":1400000000000,"achievement":{"id":6}},
+		{"type":"itemCraft","character":"Capoferro","timestamp":1400000000000,"itemId":82800}
+	]}`), guild)
+	c.Assert(err, IsNil)
+	c.Assert(guild.ItemNews(), HasLen, 1)
+	c.Assert(guild.NewsOfType(NewsItemLoot, NewsItemCraft), HasLen, 2)
+	achievements := guild.AchievementNews()
+	c.Assert(achievements, HasLen, 2)
+	c.Assert(achievements[0].Achievement.Title, Equals, "Guild Level 25")
+	c.Assert(achievements[0].IsAchievement(), Equals, true)
+	c.Assert(guild.News[3].IsItem(), Equals, true)
+	c.Assert(guild.News[3].ItemId, Equals, 82800)
+}
+
+func (s *GuildSuite) Test_MemberCharacters(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/guild/runetotem/Reforged":
+			if r.URL.Query().Get("fields") != "members" {
+				w.Write([]byte(`{"name":"Reforged","realm":"Runetotem"}`))
+				return
+			}
+			w.Write([]byte(`{"name":"Reforged","realm":"Runetotem","members":[{"character":{"name":"Capoferro","realm":"Runetotem","class":6,"level":100},"rank":0}]}`))
+		case "/wow/character/runetotem/Capoferro":
+			w.Write([]byte(`{"name":"Capoferro","realm":"Runetotem","mounts":{"numCollected":1}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	guild, err := client.GetGuild("Runetotem", "Reforged")
+	c.Assert(err, IsNil)
+	members, err := guild.MemberCharacters()
+	c.Assert(err, IsNil)
+	c.Assert(members, HasLen, 1)
+	c.Assert(members[0].Rank, Equals, 0)
+	c.Assert(members[0].Character.ClassId, Equals, 6)
+	mounts, err := members[0].Character.CollectedMounts()
+	c.Assert(err, IsNil)
+	c.Assert(mounts.NumCollected, Equals, 1)
+}
+
+func (s *GuildSuite) Test_LastModifiedTime(c *C) {
+	guild := &Guild{LastModified: 1405387200123}
+	c.Assert(guild.LastModifiedTime().Equal(time.Unix(1405387200, int64(123*time.Millisecond))), Equals, true)
+	c.Assert((&Guild{}).LastModifiedTime().IsZero(), Equals, true)
+}
+
+func (s *GuildSuite) Test_NewsItemTime(c *C) {
+	news := &GuildNewsItem{Timestamp: 1405387200123}
+	c.Assert(news.Time().Equal(time.Unix(1405387200, int64(123*time.Millisecond))), Equals, true)
+}
+
+func (s *GuildSuite) Test_Challenges(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Query().Get("fields"), Equals, "challenge")
+		w.Write([]byte(`{"name":"Reforged","realm":"Runetotem","challenge":[{"map":{"id":960,"name":"Temple of the Jade Serpent"},"groups":[
+			{"ranking":2,"time":{"time":1100000,"minutes":18,"seconds":20},"members":[{"character":{"name":"Capoferro","realm":"Runetotem"}}]},
+			{"ranking":1,"time":{"time":900000,"minutes":15},"members":[{"character":{"name":"Capoferro","realm":"Runetotem"}}]}]}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("US", "")
+	client.Host = server.Listener.Addr().String()
+	guild := &Guild{ApiClient: client, Name: "Reforged", Realm: "Runetotem"}
+	challenges, err := guild.Challenges()
+	c.Assert(err, IsNil)
+	c.Assert(challenges, HasLen, 1)
+	c.Assert(challenges[0].Map.Name, Equals, "Temple of the Jade Serpent")
+	best := challenges[0].BestGroup()
+	c.Assert(best.Ranking, Equals, 1)
+	c.Assert(best.Time.Duration(), Equals, 15*time.Minute)
+	c.Assert(best.Members[0].Character.Name, Equals, "Capoferro")
+	c.Assert((&Challenge{}).BestGroup(), IsNil)
+}
+
+func (s *GuildSuite) Test_AtLevel(c *C) {
+	rewards := []*GuildReward{{MinGuildLevel: 2}, {MinGuildLevel: 5}, {MinGuildLevel: 5}}
+	c.Assert(RewardsAtLevel(rewards, 5), HasLen, 2)
+	c.Assert(RewardsAtLevel(rewards, 3), HasLen, 0)
+	perks := []*GuildPerk{{GuildLevel: 2}, {GuildLevel: 3}}
+	c.Assert(PerksAtLevel(perks, 3), DeepEquals, []*GuildPerk{perks[1]})
+}
@@ -0,0 +1,16 @@
+package wow
+
+// HunterPet is one of a hunter's stabled pets. Creature is the npc id
+// of the tamed beast. FamilyId and FamilyName identify its creature
+// family, e.g. 1 "Wolf", which determines the family icon and
+// abilities, and Spec is its talent specialization.
+type HunterPet struct {
+	Name       string
+	Creature   int
+	Slot       int
+	Selected   bool
+	FamilyId   int
+	FamilyName string
+	Spec       *Spec
+	CalcSpec   string
+}
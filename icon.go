@@ -0,0 +1,72 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Icon sizes accepted by the IconURL methods. A size in pixels, such as
+// "56", is also passed through as-is.
+const (
+	IconSmall  = "small"
+	IconMedium = "medium"
+	IconLarge  = "large"
+)
+
+var iconSizes = map[string]string{
+	IconSmall:  "18",
+	IconMedium: "36",
+	IconLarge:  "56",
+}
+
+// iconURL returns the URL of the named icon at the given size on the
+// render host of region, or "" if there is no icon.
+func iconURL(region Region, icon string, size string) string {
+	if icon == "" {
+		return ""
+	}
+	if pixels, ok := iconSizes[size]; ok {
+		size = pixels
+	}
+	return fmt.Sprintf("https://%s/icons/%s/%s.jpg", renderHostFor(region), size, icon)
+}
+
+// iconPixels returns the size in pixels for one of the Icon sizes or
+// a size in pixels that Blizzard serves icons at.
+func iconPixels(size string) (string, error) {
+	if pixels, ok := iconSizes[size]; ok {
+		return pixels, nil
+	}
+	for _, pixels := range iconSizes {
+		if size == pixels {
+			return pixels, nil
+		}
+	}
+	return "", errors.New(fmt.Sprintf("Icon size '%s' is not valid, expected one of small, medium, large, 18, 36 or 56", size))
+}
+
+// DownloadIcon fetches the named icon, as found in the Icon field of
+// items, spells and so on, at the given size. It returns the image and
+// its content type, and uses the client's http.Client, timeout and
+// retries. The icon is fetched from the render host of the client's
+// region.
+func (a *ApiClient) DownloadIcon(icon string, size string) ([]byte, string, error) {
+	if icon == "" {
+		return nil, "", errors.New("No icon name given")
+	}
+	pixels, err := iconPixels(size)
+	if err != nil {
+		return nil, "", err
+	}
+	iconUrl, err := url.Parse(iconURL(a.Region, icon, pixels))
+	if err != nil {
+		return nil, "", err
+	}
+	image, err := a.getUrl(iconUrl)
+	if err != nil {
+		return nil, "", err
+	}
+	return image, http.DetectContentType(image), nil
+}
@@ -0,0 +1,66 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type IconSuite struct{}
+
+var _ = Suite(&IconSuite{})
+
+func (s *IconSuite) Test_iconURL(c *C) {
+	c.Assert(iconURL(RegionUS, "inv_sword_39", IconSmall), Equals, "https://render-us.worldofwarcraft.com/icons/18/inv_sword_39.jpg")
+	c.Assert(iconURL(RegionUS, "inv_sword_39", IconMedium), Equals, "https://render-us.worldofwarcraft.com/icons/36/inv_sword_39.jpg")
+	c.Assert(iconURL(RegionEU, "inv_sword_39", "56"), Equals, "https://render-eu.worldofwarcraft.com/icons/56/inv_sword_39.jpg")
+}
+
+func (s *IconSuite) Test_iconURL_noIcon(c *C) {
+	c.Assert(iconURL(RegionUS, "", IconLarge), Equals, "")
+}
+
+func (s *IconSuite) Test_ItemIconURL(c *C) {
+	item := &Item{Icon: "inv_sword_39"}
+	c.Assert(item.IconURL(RegionUS, IconLarge), Equals, "https://render-us.worldofwarcraft.com/icons/56/inv_sword_39.jpg")
+	c.Assert(item.IconURL(RegionKR, IconLarge), Equals, "https://render-kr.worldofwarcraft.com/icons/56/inv_sword_39.jpg")
+}
+
+// rewriteTransport sends every request to host instead.
+type rewriteTransport struct {
+	host string
+}
+
+func (t *rewriteTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	rewritten := *request.URL
+	rewritten.Scheme = "http"
+	rewritten.Host = t.host
+	request = request.Clone(request.Context())
+	request.URL = &rewritten
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func (s *IconSuite) Test_DownloadIcon(c *C) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Host, Equals, "render-eu.worldofwarcraft.com")
+		c.Check(r.URL.Path, Equals, "/icons/56/inv_sword_39.jpg")
+		c.Check(r.URL.RawQuery, Equals, "")
+		w.Write(png)
+	}))
+	defer server.Close()
+
+	client, _ := NewApiClient("EU", "", WithSecret("secret"), WithHttpClient(&http.Client{
+		Transport: &rewriteTransport{host: server.Listener.Addr().String()},
+	}))
+	image, contentType, err := client.DownloadIcon("inv_sword_39", IconLarge)
+	c.Assert(err, IsNil)
+	c.Assert(image, DeepEquals, png)
+	c.Assert(contentType, Equals, "image/png")
+}
+
+func (s *IconSuite) Test_DownloadIcon_invalidSize(c *C) {
+	client, _ := NewApiClient("US", "")
+	_, _, err := client.DownloadIcon("inv_sword_39", "huge")
+	c.Assert(err, ErrorMatches, "Icon size 'huge' is not valid.*")
+}
@@ -2,6 +2,7 @@ package wow
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 type Item struct {
@@ -43,11 +44,22 @@ type Item struct {
 	SellPrice              int
 	Stackable              int
 	Upgradable             bool
+	Context                string
+	BonusLists             []int
+	AvailableContexts      []string
+	BonusSummary           *BonusSummary
+	// RawJSON is the response the item was decoded from.
+	RawJSON []byte `json:"-"`
 }
 
 func NewItemFromJson(jsonBlob []byte) (*Item, error) {
+	return newItem(jsonBlob, json.Unmarshal)
+}
+
+// newItem is NewItemFromJson with the JSON decoded by unmarshal.
+func newItem(jsonBlob []byte, unmarshal func([]byte, interface{}) error) (*Item, error) {
 	item := &Item{}
-	err := json.Unmarshal(jsonBlob, item)
+	err := unmarshal(jsonBlob, item)
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +70,106 @@ func NewItemFromJson(jsonBlob []byte) (*Item, error) {
 	if len(item.BonusStats) == 0 {
 		item.BonusStats = item.Stats
 	}
+	item.RawJSON = jsonBlob
 
 	return item, nil
 }
+
+// IconURL returns the URL of the item's icon at the given size, e.g.
+// IconLarge, on the render host of region.
+func (i *Item) IconURL(region Region, size string) string {
+	return iconURL(region, i.Icon, size)
+}
+
+// Subclass finds the item's subclass in classes, as returned by
+// GetItemClasses, or returns nil if it isn't there.
+func (i *Item) Subclass(classes []*ItemClass) *ItemSubclass {
+	for _, class := range classes {
+		if class.Class == i.ItemClass {
+			return class.Subclass(i.ItemSubclass)
+		}
+	}
+	return nil
+}
+
+var itemBindNames = map[int]string{
+	1: "Binds when picked up",
+	2: "Binds when equipped",
+	3: "Binds when used",
+	4: "Quest Item",
+}
+
+var inventoryTypeNames = map[int]string{
+	1:  "Head",
+	2:  "Neck",
+	3:  "Shoulder",
+	4:  "Shirt",
+	5:  "Chest",
+	6:  "Waist",
+	7:  "Legs",
+	8:  "Feet",
+	9:  "Wrist",
+	10: "Hands",
+	11: "Finger",
+	12: "Trinket",
+	13: "One-Hand",
+	14: "Off Hand",
+	15: "Ranged",
+	16: "Back",
+	17: "Two-Hand",
+	18: "Bag",
+	19: "Tabard",
+	20: "Chest",
+	21: "Main Hand",
+	22: "Off Hand",
+	23: "Held In Off-hand",
+	25: "Thrown",
+	26: "Ranged",
+}
+
+// Tooltip returns the item's tooltip as lines of text, in the order the
+// game shows them. Lines for data the item doesn't have, such as stats
+// or weapon damage, are left out.
+func (i *Item) Tooltip() []string {
+	lines := []string{i.Name}
+	if i.NameDescription != "" {
+		lines = append(lines, i.NameDescription)
+	}
+	if i.ItemLevel > 0 {
+		lines = append(lines, fmt.Sprintf("Item Level %d", i.ItemLevel))
+	}
+	if bind, ok := itemBindNames[i.ItemBind]; ok {
+		lines = append(lines, bind)
+	}
+	if i.MaxCount == 1 {
+		lines = append(lines, "Unique")
+	}
+	if slot, ok := inventoryTypeNames[i.InventoryType]; ok {
+		lines = append(lines, slot)
+	}
+	if i.WeaponInfo != nil && i.WeaponInfo.Damage != nil {
+		lines = append(lines,
+			fmt.Sprintf("%d - %d Damage", i.WeaponInfo.Damage.Min, i.WeaponInfo.Damage.Max),
+			fmt.Sprintf("Speed %.2f", i.WeaponInfo.WeaponSpeed),
+			fmt.Sprintf("(%.1f damage per second)", i.WeaponInfo.DPS))
+	}
+	if i.Armor > 0 {
+		lines = append(lines, fmt.Sprintf("%d Armor", i.Armor))
+	}
+	for _, stat := range i.BonusStats {
+		lines = append(lines, stat.String())
+	}
+	if i.MaxDurability > 0 {
+		lines = append(lines, fmt.Sprintf("Durability %d / %d", i.MaxDurability, i.MaxDurability))
+	}
+	if i.RequiredLevel > 1 {
+		lines = append(lines, fmt.Sprintf("Requires Level %d", i.RequiredLevel))
+	}
+	if i.Description != "" {
+		lines = append(lines, `"`+i.Description+`"`)
+	}
+	if i.SellPrice > 0 {
+		lines = append(lines, fmt.Sprintf("Sell Price: %dg %ds %dc", i.SellPrice/10000, i.SellPrice/100%100, i.SellPrice%100))
+	}
+	return lines
+}
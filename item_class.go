@@ -5,3 +5,14 @@ type ItemClass struct {
 	Name       string
 	Subclasses []*ItemSubclass
 }
+
+// Subclass returns the class's subclass with the given id, or nil if
+// there isn't one. Ids are the same as Item.ItemSubclass.
+func (ic *ItemClass) Subclass(id int) *ItemSubclass {
+	for _, subclass := range ic.Subclasses {
+		if subclass.Subclass == id {
+			return subclass
+		}
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ type ItemList struct {
 	Back                     *Item
 	Chest                    *Item
 	Shirt                    *Item
+	Tabard                   *Item
 	Wrist                    *Item
 	Hands                    *Item
 	Waist                    *Item
@@ -21,3 +22,67 @@ type ItemList struct {
 	MainHand                 *Item
 	OffHand                  *Item
 }
+
+// ItemSlots are the equipment slot names used by the API and by
+// ItemList.Slots, in paper doll order.
+var ItemSlots = []string{
+	"head", "neck", "shoulder", "back", "chest", "shirt", "tabard",
+	"wrist", "hands", "waist", "legs", "feet", "finger1", "finger2",
+	"trinket1", "trinket2", "mainHand", "offHand",
+}
+
+// Slot returns the item equipped in the named slot, one of ItemSlots,
+// or nil if the slot is empty or unknown.
+func (l *ItemList) Slot(slot string) *Item {
+	switch slot {
+	case "head":
+		return l.Head
+	case "neck":
+		return l.Neck
+	case "shoulder":
+		return l.Shoulder
+	case "back":
+		return l.Back
+	case "chest":
+		return l.Chest
+	case "shirt":
+		return l.Shirt
+	case "tabard":
+		return l.Tabard
+	case "wrist":
+		return l.Wrist
+	case "hands":
+		return l.Hands
+	case "waist":
+		return l.Waist
+	case "legs":
+		return l.Legs
+	case "feet":
+		return l.Feet
+	case "finger1":
+		return l.Finger1
+	case "finger2":
+		return l.Finger2
+	case "trinket1":
+		return l.Trinket1
+	case "trinket2":
+		return l.Trinket2
+	case "mainHand":
+		return l.MainHand
+	case "offHand":
+		return l.OffHand
+	}
+	return nil
+}
+
+// Slots returns the equipped items keyed by slot name. Empty slots are
+// left out.
+func (l *ItemList) Slots() map[string]*Item {
+	slots := make(map[string]*Item)
+	for _, slot := range ItemSlots {
+		if item := l.Slot(slot); item != nil {
+			slots[slot] = item
+		}
+	}
+	return slots
+}
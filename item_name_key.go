@@ -0,0 +1,7 @@
+package wow
+
+// itemNameKey identifies a name cached by ItemName.
+type itemNameKey struct {
+	id     int
+	locale string
+}
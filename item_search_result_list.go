@@ -0,0 +1,57 @@
+package wow
+
+// itemSearchResultList is the game data API's item search format,
+// whose entries itemSearchResult converts to Items.
+type itemSearchResultList struct {
+	Results []*struct {
+		Data *itemSearchResult
+	}
+}
+
+type itemSearchResult struct {
+	Id      int
+	Name    map[string]string
+	Level   int
+	Quality struct {
+		Type string
+	}
+	RequiredLevel int `json:"required_level"`
+	ItemClass     struct {
+		Id int
+	} `json:"item_class"`
+	ItemSubclass struct {
+		Id int
+	} `json:"item_subclass"`
+	SellPrice     int  `json:"sell_price"`
+	PurchasePrice int  `json:"purchase_price"`
+	IsEquippable  bool `json:"is_equippable"`
+}
+
+// itemQualities maps the game data API's quality types to the
+// community API's Item.Quality numbers.
+var itemQualities = map[string]int{
+	"POOR":      0,
+	"COMMON":    1,
+	"UNCOMMON":  2,
+	"RARE":      3,
+	"EPIC":      4,
+	"LEGENDARY": 5,
+	"ARTIFACT":  6,
+	"HEIRLOOM":  7,
+}
+
+// item converts the result, taking its name in locale.
+func (r *itemSearchResult) item(locale string) *Item {
+	return &Item{
+		Id:            r.Id,
+		Name:          r.Name[locale],
+		Quality:       itemQualities[r.Quality.Type],
+		ItemLevel:     r.Level,
+		RequiredLevel: r.RequiredLevel,
+		ItemClass:     r.ItemClass.Id,
+		ItemSubclass:  r.ItemSubclass.Id,
+		SellPrice:     r.SellPrice,
+		BuyPrice:      r.PurchasePrice,
+		Equipable:     r.IsEquippable,
+	}
+}
@@ -1,8 +1,72 @@
 package wow
 
+import (
+	"errors"
+	"sort"
+)
+
 type ItemSet struct {
 	Id         int
 	Items      []int
 	Name       string
 	SetBonuses []*SetBonus
 }
+
+// Bonuses returns the set's bonuses ordered by the number of pieces
+// they require.
+func (s *ItemSet) Bonuses() []*SetBonus {
+	bonuses := make([]*SetBonus, len(s.SetBonuses))
+	copy(bonuses, s.SetBonuses)
+	sort.SliceStable(bonuses, func(i, j int) bool {
+		return bonuses[i].Threshold < bonuses[j].Threshold
+	})
+	return bonuses
+}
+
+// ActiveBonuses returns the bonuses granted for wearing the given
+// number of pieces, ordered as Bonuses.
+func (s *ItemSet) ActiveBonuses(pieces int) []*SetBonus {
+	active := make([]*SetBonus, 0, len(s.SetBonuses))
+	for _, bonus := range s.Bonuses() {
+		if bonus.Threshold <= pieces {
+			active = append(active, bonus)
+		}
+	}
+	return active
+}
+
+// HasItem reports whether the item with the given id is part of the
+// set.
+func (s *ItemSet) HasItem(id int) bool {
+	for _, item := range s.Items {
+		if item == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveItems fetches the set's items through client, or through
+// CurrentApiClient if client is nil or a nil *ApiClient, in the order
+// of Items. If some items can't be fetched, the others are returned
+// along with a *BatchError.
+func (s *ItemSet) ResolveItems(client ItemClient) ([]*Item, error) {
+	if apiClient, ok := client.(*ApiClient); ok && apiClient == nil {
+		client = nil
+	}
+	if client == nil {
+		current := CurrentApiClient()
+		if current == nil {
+			return nil, errors.New("No API client given and no current API client. Pass one or register one via SetCurrentApiClient")
+		}
+		client = current
+	}
+	byId, err := client.GetItems(s.Items)
+	items := make([]*Item, 0, len(s.Items))
+	for _, id := range s.Items {
+		if item, ok := byId[id]; ok {
+			items = append(items, item)
+		}
+	}
+	return items, err
+}
@@ -0,0 +1,48 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type ItemSuite struct{}
+
+var _ = Suite(&ItemSuite{})
+
+func (s *ItemSuite) Test_Tooltip(c *C) {
+	item, err := NewItemFromJson([]byte(`{"id":18803,"name":"Finkle's Lava Dredger","description":"Hot, hot, hot!","itemLevel":70,"itemBind":1,"inventoryType":17,"maxDurability":120,"requiredLevel":60,"sellPrice":116525,
+		"bonusStats":[{"stat":4,"amount":25},{"stat":7,"amount":17}],
+		"weaponInfo":{"damage":{"min":169,"max":254},"weaponSpeed":3.2,"dps":66.09375}}`))
+	c.Assert(err, IsNil)
+	c.Assert(item.Tooltip(), DeepEquals, []string{
+		"Finkle's Lava Dredger",
+		"Item Level 70",
+		"Binds when picked up",
+		"Two-Hand",
+		"169 - 254 Damage",
+		"Speed 3.20",
+		"(66.1 damage per second)",
+		"+25 Strength",
+		"+17 Stamina",
+		"Durability 120 / 120",
+		"Requires Level 60",
+		`"Hot, hot, hot!"`,
+		"Sell Price: 11g 65s 25c",
+	})
+}
+
+func (s *ItemSuite) Test_Tooltip_noStats(c *C) {
+	item := &Item{Name: "Linen Cloth"}
+	c.Assert(item.Tooltip(), DeepEquals, []string{"Linen Cloth"})
+}
+
+func (s *ItemSuite) Test_Subclass(c *C) {
+	classes := []*ItemClass{
+		{Class: 2, Name: "Weapon", Subclasses: []*ItemSubclass{{Subclass: 0, Name: "Axe"}, {Subclass: 7, Name: "Sword"}}},
+		{Class: 4, Name: "Armor", Subclasses: []*ItemSubclass{{Subclass: 0, Name: "Miscellaneous"}}},
+	}
+	item := &Item{ItemClass: 2, ItemSubclass: 7}
+	c.Assert(item.Subclass(classes).Name, Equals, "Sword")
+	c.Assert(classes[1].Subclass(0).Name, Equals, "Miscellaneous")
+	c.Assert(classes[1].Subclass(7), IsNil)
+	c.Assert((&Item{ItemClass: 9}).Subclass(classes), IsNil)
+}
@@ -0,0 +1,56 @@
+package wow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// decodeArrayField reads a JSON object from decoder and calls each
+// once per element of its array field named field (matched case
+// insensitively), with the decoder positioned at the element. each
+// must consume the element, e.g. with decoder.Decode. Other fields are
+// skipped. Elements are decoded one at a time, so large arrays are
+// never held in memory as a whole.
+func decodeArrayField(decoder *json.Decoder, field string, each func(decoder *json.Decoder) error) error {
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if name, _ := key.(string); !strings.EqualFold(name, field) {
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := expectDelim(decoder, '['); err != nil {
+			return err
+		}
+		for decoder.More() {
+			if err := each(decoder); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, '}')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return errors.New(fmt.Sprintf("Unexpected %v in response, expected %v", token, delim))
+	}
+	return nil
+}
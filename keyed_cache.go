@@ -0,0 +1,34 @@
+package wow
+
+import "sync"
+
+// keyedCache is a map safe for concurrent use, for values that are
+// kept for the life of the client, such as connected realm ids.
+type keyedCache[K comparable, V any] struct {
+	mu     sync.RWMutex
+	values map[K]V
+}
+
+// get and set do nothing on a nil *keyedCache, like cacheCounters.
+func (c *keyedCache[K, V]) get(key K) (V, bool) {
+	if c == nil {
+		var zero V
+		return zero, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	value, ok := c.values[key]
+	return value, ok
+}
+
+func (c *keyedCache[K, V]) set(key K, value V) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.values == nil {
+		c.values = make(map[K]V)
+	}
+	c.values[key] = value
+}
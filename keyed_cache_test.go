@@ -0,0 +1,28 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type KeyedCacheSuite struct{}
+
+var _ = Suite(&KeyedCacheSuite{})
+
+func (s *KeyedCacheSuite) Test_getSet(c *C) {
+	cache := &keyedCache[itemNameKey, string]{}
+	_, ok := cache.get(itemNameKey{18803, "en_US"})
+	c.Assert(ok, Equals, false)
+	cache.set(itemNameKey{18803, "en_US"}, "Finkle's Lava Dredger")
+	cache.set(itemNameKey{18803, "de_DE"}, "Finkles Lavabagger")
+	name, ok := cache.get(itemNameKey{18803, "de_DE"})
+	c.Assert(ok, Equals, true)
+	c.Assert(name, Equals, "Finkles Lavabagger")
+}
+
+func (s *KeyedCacheSuite) Test_nil(c *C) {
+	var cache *keyedCache[string, int]
+	cache.set("argent-dawn", 3693)
+	id, ok := cache.get("argent-dawn")
+	c.Assert(ok, Equals, false)
+	c.Assert(id, Equals, 0)
+}
@@ -0,0 +1,6 @@
+package wow
+
+type Location struct {
+	Id   int
+	Name string
+}
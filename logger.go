@@ -0,0 +1,45 @@
+package wow
+
+import (
+	"time"
+)
+
+// RequestEvent describes one HTTP request made by the client. Retries
+// are logged as separate events with increasing Attempt numbers.
+type RequestEvent struct {
+	// Path is the URL path requested. The query string, which may
+	// carry the API key, is left out.
+	Path       string
+	StatusCode int
+	Duration   time.Duration
+	// Attempt is 0 for the first try and counts up with each retry.
+	Attempt int
+	Err     error
+}
+
+// Logger receives an event for each request the client makes.
+type Logger interface {
+	LogRequest(event *RequestEvent)
+}
+
+// LoggerFunc adapts a function to the Logger interface.
+type LoggerFunc func(event *RequestEvent)
+
+func (f LoggerFunc) LogRequest(event *RequestEvent) {
+	f(event)
+}
+
+// statusCode returns the HTTP status that err stands for: 200 for nil,
+// the response status for an *ApiError and 0 for anything else, such
+// as a network error.
+func statusCode(err error) int {
+	switch err := err.(type) {
+	case nil:
+		return 200
+	case *ApiError:
+		return err.StatusCode
+	case *RateLimitError:
+		return err.StatusCode
+	}
+	return 0
+}
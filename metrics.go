@@ -0,0 +1,53 @@
+package wow
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricsFunc is called once per API call made through the client,
+// including those answered from the cache. endpoint is the request's
+// path with ids and names replaced by placeholders, such as
+// "character/:realm/:name" or "item/:id", so that it can be used as a
+// metric label. Auction file downloads are all reported as
+// "auction/data/file". statusCode is as for RequestEvent.
+type MetricsFunc func(endpoint string, statusCode int, elapsed time.Duration)
+
+// auctionFileEndpoint is the Metrics endpoint for downloads of the
+// auction files listed by GetAuctionData, whose URLs vary with every
+// dump.
+const auctionFileEndpoint = "auction/data/file"
+
+// namedEndpoints are the endpoints whose paths contain names rather
+// than numeric ids.
+var namedEndpoints = [][]string{
+	strings.Split("auction/data/:realm", "/"),
+	strings.Split("challenge/:realm", "/"),
+	strings.Split("character/:realm/:name", "/"),
+	strings.Split("guild/:realm/:name", "/"),
+	strings.Split("/data/wow/realm/:realm", "/"),
+}
+
+// endpointLabel returns the template that path was built from.
+func endpointLabel(path string) string {
+	segments := strings.Split(path, "/")
+templates:
+	for _, template := range namedEndpoints {
+		if len(template) != len(segments) {
+			continue
+		}
+		for i, segment := range template {
+			if !strings.HasPrefix(segment, ":") && segment != segments[i] {
+				continue templates
+			}
+		}
+		return strings.Join(template, "/")
+	}
+	for i, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil {
+			segments[i] = ":id"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,18 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type MetricsSuite struct{}
+
+var _ = Suite(&MetricsSuite{})
+
+func (s *MetricsSuite) Test_endpointLabel(c *C) {
+	c.Assert(endpointLabel("item/18803"), Equals, "item/:id")
+	c.Assert(endpointLabel("character/argent-dawn/Capoferro"), Equals, "character/:realm/:name")
+	c.Assert(endpointLabel("guild/runetotem/Reforged"), Equals, "guild/:realm/:name")
+	c.Assert(endpointLabel("/data/wow/realm/argent-dawn"), Equals, "/data/wow/realm/:realm")
+	c.Assert(endpointLabel("/data/wow/connected-realm/11"), Equals, "/data/wow/connected-realm/:id")
+	c.Assert(endpointLabel("/data/wow/token/index"), Equals, "/data/wow/token/index")
+}
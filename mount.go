@@ -1,14 +1,27 @@
 package wow
 
+// Mount is used both for the data/mount master list and a character's
+// mount collection, which name some fields differently: the master
+// list sets IsGroundMount and IsFlyingMount, collections set IsGround
+// and IsFlying.
 type Mount struct {
-	Name       string
-	SpellId    int
-	CreatureId int
-	ItemId     int
-	Quality    int
-	Icon       string
-	IsGround   bool
-	IsFlying   bool
-	IsAquatic  bool
-	IsJumping  bool
+	Name          string
+	SpellId       int
+	CreatureId    int
+	ItemId        int
+	Quality       int
+	QualityId     int
+	Icon          string
+	IsGround      bool
+	IsFlying      bool
+	IsGroundMount bool
+	IsFlyingMount bool
+	IsAquatic     bool
+	IsJumping     bool
+}
+
+// IconURL returns the URL of the mount's icon at the given size, e.g.
+// IconLarge, on the render host of region.
+func (m *Mount) IconURL(region Region, size string) string {
+	return iconURL(region, m.Icon, size)
 }
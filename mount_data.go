@@ -0,0 +1,5 @@
+package wow
+
+type mountData struct {
+	Mounts []*Mount
+}
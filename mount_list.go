@@ -5,3 +5,13 @@ type MountList struct {
 	NumNotCollected int
 	Collected       []*Mount
 }
+
+// SpellIds returns the summon spell id of each collected mount, which
+// identifies the mount.
+func (m *MountList) SpellIds() []int {
+	ids := make([]int, 0, len(m.Collected))
+	for _, mount := range m.Collected {
+		ids = append(ids, mount.SpellId)
+	}
+	return ids
+}
@@ -0,0 +1,18 @@
+package wow
+
+import (
+	"time"
+)
+
+type MythicKeystoneGroup struct {
+	Ranking            int
+	Duration           int
+	CompletedTimestamp uint64 `json:"completed_timestamp"`
+	KeystoneLevel      int    `json:"keystone_level"`
+	Members            []*MythicKeystoneMember
+}
+
+// Time returns how long the run took. Duration is in milliseconds.
+func (m *MythicKeystoneGroup) Time() time.Duration {
+	return time.Duration(m.Duration) * time.Millisecond
+}
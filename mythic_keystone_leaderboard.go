@@ -0,0 +1,11 @@
+package wow
+
+type MythicKeystoneLeaderboard struct {
+	Name                 string
+	Map                  *DataReference
+	MapChallengeModeId   int `json:"map_challenge_mode_id"`
+	Period               int
+	PeriodStartTimestamp uint64                 `json:"period_start_timestamp"`
+	PeriodEndTimestamp   uint64                 `json:"period_end_timestamp"`
+	LeadingGroups        []*MythicKeystoneGroup `json:"leading_groups"`
+}
@@ -0,0 +1,7 @@
+package wow
+
+type MythicKeystoneMember struct {
+	Profile        *MythicKeystoneProfile
+	Faction        *TypeName
+	Specialization *DataReference
+}
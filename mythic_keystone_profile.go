@@ -0,0 +1,7 @@
+package wow
+
+type MythicKeystoneProfile struct {
+	Id    int
+	Name  string
+	Realm *DataReference
+}
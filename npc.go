@@ -0,0 +1,8 @@
+package wow
+
+type Npc struct {
+	Id                int
+	Name              string
+	UrlSlug           string
+	CreatureDisplayId int
+}
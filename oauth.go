@@ -0,0 +1,144 @@
+package wow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NewOAuthApiClient returns an ApiClient that authenticates with
+// Blizzard's OAuth2 client credentials flow instead of an API key.
+// An access token is requested on first use and reused until it
+// expires. Region, locale and options are handled as in NewApiClient.
+func NewOAuthApiClient(region Region, locale string, clientId string, clientSecret string, opts ...Option) (*ApiClient, error) {
+	client, err := NewApiClient(region, locale, opts...)
+	if err != nil {
+		return nil, err
+	}
+	info := regions[client.Region]
+	client.Host = info.gatewayHost
+	client.tokens = &tokenSource{
+		tokenUrl:     info.tokenUrl,
+		clientId:     clientId,
+		clientSecret: clientSecret,
+	}
+	return client, nil
+}
+
+// Tokens are refreshed this long before they actually expire so that
+// an in-flight request never carries a token that lapses mid-way.
+const tokenExpiryBuffer = time.Minute
+
+// tokenSource fetches and caches an OAuth2 access token. It is shared
+// by every copy of the ApiClient it belongs to, and sem ensures only
+// one goroutine fetches a new token while the others wait for it.
+type tokenSource struct {
+	once         sync.Once
+	sem          chan struct{}
+	tokenUrl     string
+	clientId     string
+	clientSecret string
+	accessToken  string
+	expiry       time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// token returns the cached access token, requesting a new one if there
+// is none or it is about to expire. The request, and any wait for
+// another goroutine's request, is abandoned with ctx.Err() when ctx is
+// done.
+func (t *tokenSource) token(ctx context.Context, client *http.Client) (string, error) {
+	if err := t.lock(ctx); err != nil {
+		return "", err
+	}
+	defer t.unlock()
+	if t.accessToken != "" && time.Now().Add(tokenExpiryBuffer).Before(t.expiry) {
+		return t.accessToken, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	request, err := http.NewRequestWithContext(ctx, "POST", t.tokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.SetBasicAuth(t.clientId, t.clientSecret)
+
+	response, err := client.Do(request)
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return "", &ApiError{StatusCode: response.StatusCode, Path: request.URL.Path, Body: string(body)}
+	}
+
+	tokenResponse := &tokenResponse{}
+	err = json.Unmarshal(body, tokenResponse)
+	if err != nil {
+		return "", err
+	}
+	if tokenResponse.AccessToken == "" {
+		return "", errors.New(fmt.Sprintf("No access token in response from %s", t.tokenUrl))
+	}
+	t.accessToken = tokenResponse.AccessToken
+	t.expiry = time.Now().Add(time.Duration(tokenResponse.ExpiresIn) * time.Second)
+	return t.accessToken, nil
+}
+
+// lock waits for exclusive use of the token, unlike a mutex giving up
+// if ctx is done first so that callers don't hang behind a stalled
+// token request.
+func (t *tokenSource) lock(ctx context.Context) error {
+	t.once.Do(func() { t.sem = make(chan struct{}, 1) })
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case t.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *tokenSource) unlock() {
+	<-t.sem
+}
+
+// clear discards the cached token, whatever it is.
+func (t *tokenSource) clear() {
+	t.lock(context.Background())
+	defer t.unlock()
+	t.accessToken = ""
+}
+
+// invalidate discards token so the next call to token fetches a new
+// one. It does nothing if token has already been replaced.
+func (t *tokenSource) invalidate(token string) {
+	t.lock(context.Background())
+	defer t.unlock()
+	if t.accessToken == token {
+		t.accessToken = ""
+	}
+}
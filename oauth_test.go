@@ -0,0 +1,170 @@
+package wow
+
+import (
+	"context"
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+type OAuthSuite struct{}
+
+var _ = Suite(&OAuthSuite{})
+
+func (s *OAuthSuite) Test_token_cachedAndSent(c *C) {
+	tokenRequests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			id, secret, _ := r.BasicAuth()
+			c.Check(id, Equals, "id")
+			c.Check(secret, Equals, "secret")
+			c.Check(r.FormValue("grant_type"), Equals, "client_credentials")
+			w.Write([]byte(`{"access_token":"abc","token_type":"bearer","expires_in":86399}`))
+			return
+		}
+		c.Check(r.Header.Get("Authorization"), Equals, "Bearer abc")
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewOAuthApiClient("US", "", "id", "secret")
+	c.Assert(client.Host, Equals, "us.api.blizzard.com")
+	client.Host = server.Listener.Addr().String()
+	client.HttpClient = server.Client()
+	client.tokens.tokenUrl = server.URL + "/oauth/token"
+
+	_, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	_, err = client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(tokenRequests, Equals, 1)
+}
+
+func (s *OAuthSuite) Test_token_refreshedOn401(c *C) {
+	tokenRequests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			w.Write([]byte(fmt.Sprintf(`{"access_token":"token%d","expires_in":86399}`, tokenRequests)))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewOAuthApiClient("US", "", "id", "secret")
+	client.Host = server.Listener.Addr().String()
+	client.HttpClient = server.Client()
+	client.tokens.tokenUrl = server.URL + "/oauth/token"
+
+	a, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(a.Id, Equals, 2144)
+	c.Assert(tokenRequests, Equals, 2)
+}
+
+func (s *OAuthSuite) Test_Close(c *C) {
+	tokenRequests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			w.Write([]byte(`{"access_token":"abc","expires_in":86399}`))
+			return
+		}
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewOAuthApiClient("US", "", "id", "secret")
+	client.Host = server.Listener.Addr().String()
+	client.HttpClient = server.Client()
+	client.tokens.tokenUrl = server.URL + "/oauth/token"
+
+	_, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(client.Close(), IsNil)
+	c.Assert(client.Close(), IsNil)
+	_, err = client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(tokenRequests, Equals, 2)
+}
+
+func (s *OAuthSuite) Test_Close_sharedPool(c *C) {
+	var mu sync.Mutex
+	connections := 0
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":2144}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mu.Lock()
+			connections++
+			mu.Unlock()
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	closed, _ := NewApiClient("US", "")
+	closed.Host = server.Listener.Addr().String()
+	other, _ := NewApiClient("US", "")
+	other.Host = server.Listener.Addr().String()
+
+	_, err := other.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(closed.Close(), IsNil)
+	_, err = other.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	mu.Lock()
+	defer mu.Unlock()
+	c.Assert(connections, Equals, 1)
+}
+
+func (s *OAuthSuite) Test_token_honorsContext(c *C) {
+	arrived := make(chan struct{}, 2)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		w.Write([]byte(`{"access_token":"abc","expires_in":86399}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	t := &tokenSource{tokenUrl: server.URL}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := t.token(ctx, server.Client())
+	c.Assert(err, Equals, context.DeadlineExceeded)
+	<-arrived
+
+	// A second caller waiting on a stalled refresh gives up too.
+	go t.token(context.Background(), server.Client())
+	<-arrived
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = t.token(ctx, server.Client())
+	c.Assert(err, Equals, context.DeadlineExceeded)
+}
+
+func (s *OAuthSuite) Test_token_refreshedBeforeExpiry(c *C) {
+	t := &tokenSource{accessToken: "old", expiry: time.Now().Add(tokenExpiryBuffer / 2)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"new","expires_in":86399}`))
+	}))
+	defer server.Close()
+	t.tokenUrl = server.URL
+
+	token, err := t.token(context.Background(), server.Client())
+	c.Assert(err, IsNil)
+	c.Assert(token, Equals, "new")
+}
@@ -0,0 +1,121 @@
+package wow
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures an ApiClient created by NewApiClient.
+type Option func(*ApiClient)
+
+// WithSecret sets the API key sent with every request.
+func WithSecret(secret string) Option {
+	return func(a *ApiClient) {
+		a.Secret = secret
+	}
+}
+
+// WithPublicKey sets the public key used to sign requests.
+func WithPublicKey(publicKey string) Option {
+	return func(a *ApiClient) {
+		a.PublicKey = publicKey
+	}
+}
+
+// WithHttpClient sets the http.Client used to make requests.
+func WithHttpClient(client *http.Client) Option {
+	return func(a *ApiClient) {
+		a.HttpClient = client
+	}
+}
+
+// WithTimeout sets the per-request timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(a *ApiClient) {
+		a.Timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(a *ApiClient) {
+		a.UserAgent = userAgent
+	}
+}
+
+// WithRetries sets how many times failed requests are retried and the
+// delay before the first retry.
+func WithRetries(maxRetries int, baseDelay time.Duration) Option {
+	return func(a *ApiClient) {
+		a.MaxRetries = maxRetries
+		a.RetryBaseDelay = baseDelay
+	}
+}
+
+// WithRateLimit paces requests as described by ApiClient.SetRateLimit.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(a *ApiClient) {
+		a.SetRateLimit(requestsPerSecond, burst)
+	}
+}
+
+// WithBatchConcurrency sets how many requests batch methods such as
+// GetItems make at once.
+func WithBatchConcurrency(concurrency int) Option {
+	return func(a *ApiClient) {
+		a.BatchConcurrency = concurrency
+	}
+}
+
+// WithCache stores successful responses in cache for ttl.
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(a *ApiClient) {
+		a.Cache = cache
+		a.CacheTTL = ttl
+	}
+}
+
+// WithConditionalRequests enables ETag and Last-Modified revalidation
+// as described by ApiClient.EnableConditionalRequests.
+func WithConditionalRequests() Option {
+	return func(a *ApiClient) {
+		a.EnableConditionalRequests()
+	}
+}
+
+// WithBaseURL sets ApiClient.BaseURL.
+func WithBaseURL(baseUrl *url.URL) Option {
+	return func(a *ApiClient) {
+		a.BaseURL = baseUrl
+	}
+}
+
+// WithLogger sets a Logger to be told about every request made.
+func WithLogger(logger Logger) Option {
+	return func(a *ApiClient) {
+		a.Logger = logger
+	}
+}
+
+// WithMetrics sets a MetricsFunc to be called with the outcome of
+// every API call.
+func WithMetrics(metrics MetricsFunc) Option {
+	return func(a *ApiClient) {
+		a.Metrics = metrics
+	}
+}
+
+// WithPathPrefix sets ApiClient.PathPrefix.
+func WithPathPrefix(prefix string) Option {
+	return func(a *ApiClient) {
+		a.PathPrefix = prefix
+	}
+}
+
+// WithStrictDecoding sets ApiClient.StrictDecoding.
+func WithStrictDecoding() Option {
+	return func(a *ApiClient) {
+		a.StrictDecoding = true
+	}
+}
@@ -15,3 +15,12 @@ type Pet struct {
 	CreatureName                string
 	CanBattle                   bool
 }
+
+// SpeciesId returns the pet's species id, for GetBattlePetSpecies, or
+// 0 if the pet has no stats.
+func (p *Pet) SpeciesId() int {
+	if p.Stats == nil {
+		return 0
+	}
+	return p.Stats.SpeciesId
+}
@@ -5,3 +5,14 @@ type PetList struct {
 	NumNotCollected int
 	Collected       []*Pet
 }
+
+// Pet returns the collected pet with the given BattlePetGuid, such as
+// a PetSlot's, or nil if there isn't one.
+func (l *PetList) Pet(battlePetGuid string) *Pet {
+	for _, pet := range l.Collected {
+		if pet.BattlePetGuid == battlePetGuid {
+			return pet
+		}
+	}
+	return nil
+}
@@ -8,3 +8,14 @@ type Profession struct {
 	Max     int
 	Recipes []int
 }
+
+// KnowsRecipe reports whether the recipe with the given id is among
+// the profession's known recipes.
+func (p *Profession) KnowsRecipe(id int) bool {
+	for _, recipe := range p.Recipes {
+		if recipe == id {
+			return true
+		}
+	}
+	return false
+}
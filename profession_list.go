@@ -1,5 +1,7 @@
 package wow
 
+// ProfessionList splits a character's professions into primary ones,
+// such as Tailoring, and secondary ones, such as Cooking.
 type ProfessionList struct {
 	Primary   []*Profession
 	Secondary []*Profession
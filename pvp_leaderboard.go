@@ -1,5 +1,36 @@
 package wow
 
+import (
+	"bytes"
+	"encoding/json"
+)
+
 type pvpLeaderboard struct {
 	Rows []*PvPLeaderboardRow
 }
+
+// decodePvPLeaderboardRange decodes rows [offset, offset+limit) of a
+// leaderboard response one at a time, skipping the rest, so that the
+// whole leaderboard is never held as rows. A limit of 0 or less means
+// every row from offset on.
+func decodePvPLeaderboardRange(jsonBlob []byte, offset int, limit int) ([]*PvPLeaderboardRow, error) {
+	rows := make([]*PvPLeaderboardRow, 0)
+	i := 0
+	err := decodeArrayField(json.NewDecoder(bytes.NewReader(jsonBlob)), "rows", func(decoder *json.Decoder) error {
+		defer func() { i++ }()
+		if i < offset || (limit > 0 && i >= offset+limit) {
+			var skipped json.RawMessage
+			return decoder.Decode(&skipped)
+		}
+		row := &PvPLeaderboardRow{}
+		if err := decoder.Decode(row); err != nil {
+			return err
+		}
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -17,3 +17,8 @@ type PvPLeaderboardRow struct {
 	WeeklyLosses int
 	WeeklyWins   int
 }
+
+// Faction returns the row's FactionId as a Faction.
+func (r *PvPLeaderboardRow) Faction() Faction {
+	return Faction(r.FactionId)
+}
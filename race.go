@@ -6,3 +6,13 @@ type Race struct {
 	Side string
 	Name string
 }
+
+// Faction returns the faction the race belongs to. Races with an
+// unrecognised Side are treated as neutral.
+func (r *Race) Faction() Faction {
+	faction, ok := parseFaction(r.Side)
+	if !ok {
+		return FactionNeutral
+	}
+	return faction
+}
@@ -3,3 +3,14 @@ package wow
 type raceList struct {
 	Races []*Race
 }
+
+// RacesOfFaction returns the races that belong to the given faction.
+func RacesOfFaction(races []*Race, faction Faction) []*Race {
+	filtered := make([]*Race, 0, len(races))
+	for _, race := range races {
+		if race.Faction() == faction {
+			filtered = append(filtered, race)
+		}
+	}
+	return filtered
+}
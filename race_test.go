@@ -0,0 +1,24 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type RaceSuite struct{}
+
+var _ = Suite(&RaceSuite{})
+
+func (s *RaceSuite) Test_Faction(c *C) {
+	races := []*Race{
+		&Race{Id: 1, Side: "alliance", Name: "Human"},
+		&Race{Id: 2, Side: "horde", Name: "Orc"},
+		&Race{Id: 24, Side: "neutral", Name: "Pandaren"},
+		&Race{Id: 11, Side: "alliance", Name: "Draenei"},
+	}
+	c.Assert(races[1].Faction(), Equals, FactionHorde)
+	c.Assert(races[2].Faction().String(), Equals, "neutral")
+	alliance := RacesOfFaction(races, FactionAlliance)
+	c.Assert(alliance, HasLen, 2)
+	c.Assert(alliance[1].Name, Equals, "Draenei")
+	c.Assert((&Guild{Side: 1}).Faction(), Equals, FactionHorde)
+}
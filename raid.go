@@ -1,9 +1,25 @@
 package wow
 
+// Raid is a character's progress through a raid. Normal, Heroic and
+// Mythic are 0 if no bosses have been killed on that difficulty, 1 if
+// some have and 2 if all have.
 type Raid struct {
 	Name   string
 	Normal int
 	Heroic int
+	Mythic int
 	Id     int
 	Bosses []*RaidBoss
 }
+
+// BossesKilled returns how many of the raid's bosses the character
+// has killed on the given difficulty.
+func (r *Raid) BossesKilled(difficulty Difficulty) int {
+	killed := 0
+	for _, boss := range r.Bosses {
+		if boss.Killed(difficulty) {
+			killed++
+		}
+	}
+	return killed
+}
@@ -1,5 +1,7 @@
 package wow
 
+import "time"
+
 type RaidBoss struct {
 	Id              int
 	Name            string
@@ -7,8 +9,41 @@ type RaidBoss struct {
 	NormalTimestamp uint64
 	HeroicKills     int
 	HeroicTimestamp uint64
+	MythicKills     int
+	MythicTimestamp uint64
 	LFRKills        int
 	LFRTimestamp    uint64
 	FlexKills       int
 	FlexTimestamp   uint64
 }
+
+// Kills returns how many times the character has killed the boss on
+// the given difficulty, and when it last did so. The time is zero if
+// it never has.
+func (b *RaidBoss) Kills(difficulty Difficulty) (int, time.Time) {
+	var kills int
+	var timestamp uint64
+	switch difficulty {
+	case DifficultyLFR:
+		kills, timestamp = b.LFRKills, b.LFRTimestamp
+	case DifficultyFlex:
+		kills, timestamp = b.FlexKills, b.FlexTimestamp
+	case DifficultyNormal:
+		kills, timestamp = b.NormalKills, b.NormalTimestamp
+	case DifficultyHeroic:
+		kills, timestamp = b.HeroicKills, b.HeroicTimestamp
+	case DifficultyMythic:
+		kills, timestamp = b.MythicKills, b.MythicTimestamp
+	}
+	if kills == 0 || timestamp == 0 {
+		return kills, time.Time{}
+	}
+	return kills, msToTime(int64(timestamp))
+}
+
+// Killed reports whether the character has killed the boss on the
+// given difficulty.
+func (b *RaidBoss) Killed(difficulty Difficulty) bool {
+	kills, _ := b.Kills(difficulty)
+	return kills > 0
+}
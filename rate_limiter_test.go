@@ -0,0 +1,50 @@
+package wow
+
+import (
+	"context"
+	. "launchpad.net/gocheck"
+	"time"
+)
+
+type RateLimiterSuite struct{}
+
+var _ = Suite(&RateLimiterSuite{})
+
+func (s *RateLimiterSuite) Test_wait_burst(c *C) {
+	limiter := newRateLimiter(1, 3)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		c.Assert(limiter.wait(context.Background()), IsNil)
+	}
+	c.Assert(time.Since(start) < 50*time.Millisecond, Equals, true)
+}
+
+func (s *RateLimiterSuite) Test_wait_pacing(c *C) {
+	limiter := newRateLimiter(50, 1)
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		c.Assert(limiter.wait(context.Background()), IsNil)
+	}
+	// The first request uses the burst; the other five wait 20ms each.
+	elapsed := time.Since(start)
+	c.Assert(elapsed >= 90*time.Millisecond, Equals, true)
+	c.Assert(elapsed < time.Second, Equals, true)
+}
+
+func (s *RateLimiterSuite) Test_wait_cancelled(c *C) {
+	limiter := newRateLimiter(0.1, 1)
+	c.Assert(limiter.wait(context.Background()), IsNil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	c.Assert(limiter.wait(ctx), Equals, context.DeadlineExceeded)
+	c.Assert(time.Since(start) < time.Second, Equals, true)
+
+	// The cancelled wait gave its token back, so the bucket is no
+	// further in debt than after the first request.
+	limiter.mu.Lock()
+	tokens := limiter.tokens
+	limiter.mu.Unlock()
+	c.Assert(tokens > -0.01 && tokens <= 0.01, Equals, true)
+}
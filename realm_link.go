@@ -0,0 +1,9 @@
+package wow
+
+// realmLink is the part of a game data API realm that links it to its
+// connected realm.
+type realmLink struct {
+	ConnectedRealm *struct {
+		Href string
+	} `json:"connected_realm"`
+}
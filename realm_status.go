@@ -1,15 +1,16 @@
 package wow
 
 type RealmStatus struct {
-	Type        string
-	Population  string
-	Queue       bool
-	Wintergrasp *PvPZoneStatus
-	TolBarad    *PvPZoneStatus `json:"tol-barad"`
-	Status      bool
-	Name        string
-	Slug        string
-	Battlegroup string
-	Locale      string
-	Timezone    string
+	Type            string
+	Population      string
+	Queue           bool
+	Wintergrasp     *PvPZoneStatus
+	TolBarad        *PvPZoneStatus `json:"tol-barad"`
+	Status          bool
+	Name            string
+	Slug            string
+	Battlegroup     string
+	Locale          string
+	Timezone        string
+	ConnectedRealms []string `json:"connected_realms"`
 }
@@ -1,5 +1,60 @@
 package wow
 
+import (
+	"sort"
+	"strings"
+)
+
 type realmStatusList struct {
 	Realms []*RealmStatus
 }
+
+// FilterRealmStatus returns the realms for which every one of the
+// filters, such as RealmStatusOnline or RealmStatusType, returns true.
+func FilterRealmStatus(realms []*RealmStatus, filters ...func(*RealmStatus) bool) []*RealmStatus {
+	filtered := make([]*RealmStatus, 0, len(realms))
+realms:
+	for _, realm := range realms {
+		for _, filter := range filters {
+			if !filter(realm) {
+				continue realms
+			}
+		}
+		filtered = append(filtered, realm)
+	}
+	return filtered
+}
+
+// RealmStatusOnline matches realms that are up if online is true, or
+// down if it is false.
+func RealmStatusOnline(online bool) func(*RealmStatus) bool {
+	return func(realm *RealmStatus) bool {
+		return realm.Status == online
+	}
+}
+
+// RealmStatusType matches realms of the given type: "pve", "pvp", "rp"
+// or "rppvp".
+func RealmStatusType(realmType string) func(*RealmStatus) bool {
+	return func(realm *RealmStatus) bool {
+		return strings.EqualFold(realm.Type, realmType)
+	}
+}
+
+// RealmStatusPopulation matches realms with the given population:
+// "low", "medium", "high" or "full".
+func RealmStatusPopulation(population string) func(*RealmStatus) bool {
+	return func(realm *RealmStatus) bool {
+		return strings.EqualFold(realm.Population, population)
+	}
+}
+
+// SortRealmStatusByName returns a copy of realms sorted by name.
+func SortRealmStatusByName(realms []*RealmStatus) []*RealmStatus {
+	sorted := make([]*RealmStatus, len(realms))
+	copy(sorted, realms)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
@@ -0,0 +1,31 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type RealmStatusSuite struct{}
+
+var _ = Suite(&RealmStatusSuite{})
+
+var realmStatusFixture = []*RealmStatus{
+	&RealmStatus{Name: "Runetotem", Type: "pve", Population: "medium", Status: true},
+	&RealmStatus{Name: "Tichondrius", Type: "pvp", Population: "high", Status: true},
+	&RealmStatus{Name: "Illidan", Type: "pvp", Population: "full", Status: false},
+	&RealmStatus{Name: "Emerald Dream", Type: "rppvp", Population: "high", Status: true},
+}
+
+func (s *RealmStatusSuite) Test_FilterRealmStatus(c *C) {
+	realms := FilterRealmStatus(realmStatusFixture, RealmStatusOnline(true), RealmStatusType("PvP"))
+	c.Assert(len(realms), Equals, 1)
+	c.Assert(realms[0].Name, Equals, "Tichondrius")
+	c.Assert(FilterRealmStatus(realmStatusFixture, RealmStatusPopulation("high")), HasLen, 2)
+	c.Assert(FilterRealmStatus(realmStatusFixture), HasLen, 4)
+}
+
+func (s *RealmStatusSuite) Test_SortRealmStatusByName(c *C) {
+	realms := SortRealmStatusByName(realmStatusFixture)
+	c.Assert(realms[0].Name, Equals, "Emerald Dream")
+	c.Assert(realms[3].Name, Equals, "Tichondrius")
+	c.Assert(realmStatusFixture[0].Name, Equals, "Runetotem")
+}
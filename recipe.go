@@ -6,3 +6,9 @@ type Recipe struct {
 	Name       string
 	Profession string
 }
+
+// IconURL returns the URL of the recipe's icon at the given size, e.g.
+// IconLarge, on the render host of region.
+func (r *Recipe) IconURL(region Region, size string) string {
+	return iconURL(region, r.Icon, size)
+}
@@ -0,0 +1,132 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Region identifies one of Blizzard's regional API deployments.
+type Region string
+
+const (
+	RegionUS Region = "US"
+	RegionEU Region = "EU"
+	RegionKR Region = "KR"
+	RegionTW Region = "TW"
+	RegionCN Region = "CN"
+)
+
+// regionInfo holds everything that differs between regions. The first
+// locale is the region's default.
+type regionInfo struct {
+	host        string
+	gatewayHost string
+	tokenUrl    string
+	renderHost  string
+	locales     []string
+}
+
+var regions = map[Region]*regionInfo{
+	RegionUS: {
+		host:        "us.api.battle.net",
+		gatewayHost: "us.api.blizzard.com",
+		tokenUrl:    "https://us.battle.net/oauth/token",
+		renderHost:  "render-us.worldofwarcraft.com",
+		locales:     []string{"en_US", "es_MX", "pt_BR"},
+	},
+	RegionEU: {
+		host:        "eu.api.battle.net",
+		gatewayHost: "eu.api.blizzard.com",
+		tokenUrl:    "https://eu.battle.net/oauth/token",
+		renderHost:  "render-eu.worldofwarcraft.com",
+		locales:     []string{"en_GB", "es_ES", "fr_FR", "ru_RU", "de_DE", "pt_PT", "it_IT"},
+	},
+	RegionKR: {
+		host:        "kr.api.battle.net",
+		gatewayHost: "kr.api.blizzard.com",
+		tokenUrl:    "https://kr.battle.net/oauth/token",
+		renderHost:  "render-kr.worldofwarcraft.com",
+		locales:     []string{"ko_KR"},
+	},
+	RegionTW: {
+		host:        "tw.api.battle.net",
+		gatewayHost: "tw.api.blizzard.com",
+		tokenUrl:    "https://tw.battle.net/oauth/token",
+		renderHost:  "render-tw.worldofwarcraft.com",
+		locales:     []string{"zh_TW"},
+	},
+	RegionCN: {
+		host:        "api.battlenet.com.cn",
+		gatewayHost: "gateway.battlenet.com.cn",
+		tokenUrl:    "https://www.battlenet.com.cn/oauth/token",
+		renderHost:  "render.worldofwarcraft.com.cn",
+		locales:     []string{"zh_CN"},
+	},
+}
+
+var regionAliases = map[string]Region{
+	"United States": RegionUS,
+	"Europe":        RegionEU,
+	"Korea":         RegionKR,
+	"Taiwan":        RegionTW,
+	"ZH":            RegionCN,
+	"China":         RegionCN,
+}
+
+// ParseRegion converts a region code or name, such as "EU" or
+// "Europe", to a Region.
+func ParseRegion(name string) (Region, error) {
+	if _, ok := regions[Region(name)]; ok {
+		return Region(name), nil
+	}
+	if region, ok := regionAliases[name]; ok {
+		return region, nil
+	}
+	return "", errors.New(fmt.Sprintf("Region '%s' is not valid", name))
+}
+
+// DefaultLocaleForRegion returns the locale NewApiClient uses for
+// region, a code or name accepted by ParseRegion, when none is given.
+func DefaultLocaleForRegion(region string) (string, error) {
+	parsed, err := ParseRegion(region)
+	if err != nil {
+		return "", err
+	}
+	return regions[parsed].locales[0], nil
+}
+
+// ValidLocalesForRegion returns the locales accepted for region, a code
+// or name accepted by ParseRegion, starting with the default.
+func ValidLocalesForRegion(region string) ([]string, error) {
+	parsed, err := ParseRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string(nil), regions[parsed].locales...), nil
+}
+
+// locale returns the canonical spelling of locale if it is valid for
+// the region, ignoring case and surrounding whitespace. An empty
+// locale selects the region's default.
+func (r *regionInfo) locale(locale string) (string, bool) {
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return r.locales[0], true
+	}
+	for _, valid := range r.locales {
+		if strings.EqualFold(valid, locale) {
+			return valid, true
+		}
+	}
+	return "", false
+}
+
+// renderHostFor returns the host serving character renders and icons
+// for region, falling back to the US one for an unknown region.
+func renderHostFor(region Region) string {
+	if info, ok := regions[region]; ok {
+		return info.renderHost
+	}
+	return regions[RegionUS].renderHost
+}
@@ -1,9 +1,11 @@
 package wow
 
+// Reputation is a character's standing with a faction. Value is the
+// progress through the current standing, out of Max.
 type Reputation struct {
 	Id       int
 	Name     string
-	Standing int
+	Standing Standing
 	Value    int
 	Max      int
 }
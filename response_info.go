@@ -0,0 +1,69 @@
+package wow
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ResponseInfo describes the most recent response from the API, for
+// keeping an eye on how much of the key's quota is left. The counts
+// are 0 if the API didn't send the corresponding header.
+type ResponseInfo struct {
+	StatusCode    int
+	ReceivedAt    time.Time
+	QpsAllotted   int
+	QpsCurrent    int
+	QuotaAllotted int
+	QuotaCurrent  int
+	// QuotaReset is when the quota next resets, as sent by the API.
+	QuotaReset string
+	Header     http.Header
+}
+
+func newResponseInfo(response *http.Response) *ResponseInfo {
+	header := response.Header
+	return &ResponseInfo{
+		StatusCode:    response.StatusCode,
+		ReceivedAt:    time.Now(),
+		QpsAllotted:   headerInt(header, "X-Plan-Qps-Allotted"),
+		QpsCurrent:    headerInt(header, "X-Plan-Qps-Current"),
+		QuotaAllotted: headerInt(header, "X-Plan-Quota-Allotted"),
+		QuotaCurrent:  headerInt(header, "X-Plan-Quota-Current"),
+		QuotaReset:    header.Get("X-Plan-Quota-Reset"),
+		Header:        header,
+	}
+}
+
+func headerInt(header http.Header, key string) int {
+	n, _ := strconv.Atoi(header.Get(key))
+	return n
+}
+
+// responseInfoStore holds the last ResponseInfo for a client and the
+// copies made of it by WithContext and friends.
+type responseInfoStore struct {
+	mu   sync.Mutex
+	last *ResponseInfo
+}
+
+// set and get do nothing on a nil *responseInfoStore, like
+// cacheCounters.
+func (s *responseInfoStore) set(info *ResponseInfo) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.last = info
+	s.mu.Unlock()
+}
+
+func (s *responseInfoStore) get() *ResponseInfo {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
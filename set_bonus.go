@@ -2,5 +2,7 @@ package wow
 
 type SetBonus struct {
 	Description string
-	Threshold   int
+	// Threshold is the number of pieces of the set needed for the
+	// bonus.
+	Threshold int
 }
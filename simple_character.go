@@ -16,3 +16,19 @@ type SimpleCharacter struct {
 	Guild             string
 	GuildRealm        string
 }
+
+// toCharacter returns a Character with the fields the simple character
+// has, which can load the rest through client.
+func (s *SimpleCharacter) toCharacter(client *ApiClient) *Character {
+	char := NewCharacter(client)
+	char.Name = s.Name
+	char.Realm = s.Realm
+	char.Battlegroup = s.Battlegroup
+	char.ClassId = s.Class
+	char.Race = s.Race
+	char.Gender = s.Gender
+	char.Level = s.Level
+	char.AchievementPoints = s.AchievementPoints
+	char.Thumbnail = s.Thumbnail
+	return char
+}
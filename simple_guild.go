@@ -1,5 +1,7 @@
 package wow
 
+import "errors"
+
 type SimpleGuild struct {
 	Name              string
 	Realm             string
@@ -9,3 +11,19 @@ type SimpleGuild struct {
 	AchievementPoints int
 	Emblem            *GuildEmblem
 }
+
+// Expand fetches the full guild with the given fields through client,
+// or through CurrentApiClient if client is nil.
+func (g *SimpleGuild) Expand(client GuildClient, fields ...string) (*Guild, error) {
+	if client == nil {
+		current := CurrentApiClient()
+		if current == nil {
+			return nil, errors.New("No API client given and no current API client. Pass one or register one via SetCurrentApiClient")
+		}
+		client = current
+	}
+	if fields == nil {
+		fields = make([]string, 0)
+	}
+	return client.GetGuildWithFields(g.Realm, g.Name, fields)
+}
@@ -0,0 +1,49 @@
+package wow
+
+import (
+	"strings"
+	"unicode"
+)
+
+// slugReplacements maps accented letters found in realm names to the
+// ASCII letters Blizzard uses for them in slugs.
+var slugReplacements = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a",
+	'æ': "ae", 'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u",
+	'ý': "y", 'ÿ': "y",
+	'ß': "ss",
+}
+
+// Slugify converts a realm name to the slug used in API paths, e.g.
+// "Argent Dawn" to "argent-dawn" and "Kel'Thuzad" to "kelthuzad".
+// Accented letters are replaced by ASCII ones, spaces become hyphens
+// and other punctuation is dropped. Slugs are returned unchanged.
+func Slugify(realm string) string {
+	var slug strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(realm) {
+		var part string
+		switch {
+		case slugReplacements[r] != "":
+			part = slugReplacements[r]
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			part = string(r)
+		case unicode.IsSpace(r) || r == '-':
+			pendingHyphen = slug.Len() > 0
+			continue
+		default:
+			continue
+		}
+		if pendingHyphen {
+			slug.WriteByte('-')
+			pendingHyphen = false
+		}
+		slug.WriteString(part)
+	}
+	return slug.String()
+}
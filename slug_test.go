@@ -0,0 +1,18 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type SlugSuite struct{}
+
+var _ = Suite(&SlugSuite{})
+
+func (s *SlugSuite) Test_Slugify(c *C) {
+	c.Assert(Slugify("Argent Dawn"), Equals, "argent-dawn")
+	c.Assert(Slugify("Kel'Thuzad"), Equals, "kelthuzad")
+	c.Assert(Slugify("Aggra (Português)"), Equals, "aggra-portugues")
+	c.Assert(Slugify("Pozzo dell'Eternità"), Equals, "pozzo-delleternita")
+	c.Assert(Slugify("  Azjol-Nerub "), Equals, "azjol-nerub")
+	c.Assert(Slugify("argent-dawn"), Equals, "argent-dawn")
+}
@@ -10,3 +10,9 @@ type Spell struct {
 	Range       string
 	PowerCost   string
 }
+
+// IconURL returns the URL of the spell's icon at the given size, e.g.
+// IconLarge, on the render host of region.
+func (s *Spell) IconURL(region Region, size string) string {
+	return iconURL(region, s.Icon, size)
+}
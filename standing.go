@@ -0,0 +1,24 @@
+package wow
+
+// Standing is a reputation level with a faction.
+type Standing int
+
+const (
+	StandingHated Standing = iota
+	StandingHostile
+	StandingUnfriendly
+	StandingNeutral
+	StandingFriendly
+	StandingHonored
+	StandingRevered
+	StandingExalted
+)
+
+var standingNames = []string{"Hated", "Hostile", "Unfriendly", "Neutral", "Friendly", "Honored", "Revered", "Exalted"}
+
+func (s Standing) String() string {
+	if s < 0 || int(s) >= len(standingNames) {
+		return "Unknown"
+	}
+	return standingNames[s]
+}
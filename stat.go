@@ -1,8 +1,50 @@
 package wow
 
+import "fmt"
+
 type Stat struct {
 	Stat           int
 	Amount         int
 	ReforgedAmount int
 	Reforged       bool
 }
+
+// statNames are the names of the stat ids used by items.
+var statNames = map[int]string{
+	3:  "Agility",
+	4:  "Strength",
+	5:  "Intellect",
+	6:  "Spirit",
+	7:  "Stamina",
+	13: "Dodge",
+	14: "Parry",
+	31: "Hit",
+	32: "Critical Strike",
+	35: "PvP Resilience",
+	36: "Haste",
+	37: "Expertise",
+	40: "Versatility",
+	49: "Mastery",
+	57: "PvP Power",
+	59: "Multistrike",
+	61: "Speed",
+	62: "Leech",
+	63: "Avoidance",
+	71: "Agility or Strength or Intellect",
+	72: "Strength or Agility",
+	73: "Agility or Intellect",
+	74: "Strength or Intellect",
+}
+
+// Name returns the name of the stat, e.g. "Stamina".
+func (s *Stat) Name() string {
+	if name, ok := statNames[s.Stat]; ok {
+		return name
+	}
+	return fmt.Sprintf("Stat %d", s.Stat)
+}
+
+// String formats the stat as on a tooltip, e.g. "+120 Stamina".
+func (s *Stat) String() string {
+	return fmt.Sprintf("%+d %s", s.Amount, s.Name())
+}
@@ -1,6 +1,8 @@
 package wow
 
 type TalentList struct {
+	Class   string
 	Glyphs  []*Glyph
+	Specs   []*Spec
 	Talents [6][3]*Talent
 }
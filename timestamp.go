@@ -0,0 +1,9 @@
+package wow
+
+import "time"
+
+// msToTime converts a timestamp in milliseconds since the epoch, as the
+// API gives them, to a time.Time.
+func msToTime(ms int64) time.Time {
+	return time.Unix(ms/1000, ms%1000*int64(time.Millisecond))
+}
@@ -1,6 +1,16 @@
 package wow
 
+import "strings"
+
+// Title is a title a character has earned. Name contains a %s where
+// the character's name goes, e.g. "%s the Kingslayer".
 type Title struct {
-	Id   int
-	Name string
+	Id       int
+	Name     string
+	Selected bool
+}
+
+// Format returns the title applied to the given character name.
+func (t *Title) Format(characterName string) string {
+	return strings.Replace(t.Name, "%s", characterName, 1)
 }
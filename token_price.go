@@ -0,0 +1,20 @@
+package wow
+
+import "time"
+
+// TokenPrice is the current gold price of a WoW Token in a region.
+type TokenPrice struct {
+	// Price is in copper.
+	Price                int64
+	LastUpdatedTimestamp uint64 `json:"last_updated_timestamp"`
+}
+
+// Gold returns the price in whole gold.
+func (t *TokenPrice) Gold() int64 {
+	return t.Price / 10000
+}
+
+// LastUpdated returns when Blizzard last updated the price.
+func (t *TokenPrice) LastUpdated() time.Time {
+	return msToTime(int64(t.LastUpdatedTimestamp))
+}
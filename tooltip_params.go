@@ -4,6 +4,7 @@ type TooltipParams struct {
 	Gem0         int
 	Gem1         int
 	Gem2         int
+	Enchant      int
 	Set          []int
 	Reforge      int
 	TransmogItem int
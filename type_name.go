@@ -0,0 +1,8 @@
+package wow
+
+// TypeName is the game data API's enum representation: a constant Type
+// such as "UP" and a localized Name such as "Up".
+type TypeName struct {
+	Type string
+	Name string
+}
@@ -0,0 +1,27 @@
+package wow
+
+type Zone struct {
+	Id                    int
+	Name                  string
+	UrlSlug               string
+	Description           string
+	Location              *Location
+	ExpansionId           int
+	NumPlayers            string
+	IsDungeon             bool
+	IsRaid                bool
+	AdvisedMinLevel       int
+	AdvisedMaxLevel       int
+	AdvisedHeroicMinLevel int
+	AdvisedHeroicMaxLevel int
+	AvailableModes        []string
+	LfgNormalMinGearLevel int
+	LfgHeroicMinGearLevel int
+	Floors                int
+	Patch                 string
+	Bosses                []*Boss
+}
+
+func (z *Zone) NumBosses() int {
+	return len(z.Bosses)
+}
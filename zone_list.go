@@ -0,0 +1,5 @@
+package wow
+
+type zoneList struct {
+	Zones []*Zone
+}